@@ -62,12 +62,12 @@ resource "danubedata_firewall" "web" {
       order            = 300
     },
     {
-      name       = "Allow all outbound"
-      action     = "allow"
-      direction  = "outbound"
-      protocol   = "any"
-      source_ips = ["0.0.0.0/0"]
-      order      = 1000
+      name            = "Allow all outbound"
+      action          = "allow"
+      direction       = "outbound"
+      protocol        = "any"
+      destination_ips = ["0.0.0.0/0"]
+      order           = 1000
     },
   ]
 }
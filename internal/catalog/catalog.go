@@ -0,0 +1,115 @@
+// Package catalog lists the database engines the platform offers and the
+// versions each can run. It is compiled into the provider, so it backs both
+// the danubedata_database_providers data source and plan-time checks of
+// version upgrades.
+package catalog
+
+import (
+	"fmt"
+	"strings"
+)
+
+// DatabaseEngine is a database engine and the versions it can run.
+type DatabaseEngine struct {
+	ID             int64
+	Name           string
+	Type           string
+	Description    string
+	DefaultVersion string
+	DefaultPort    int64
+
+	// Versions lists the supported versions, oldest first. Upgrades move
+	// forward through this list.
+	Versions []string
+}
+
+// DatabaseEngines are the database engines offered, matching the providers
+// seeded in the DanubeData API.
+var DatabaseEngines = []DatabaseEngine{
+	{
+		ID:             1,
+		Name:           "MySQL",
+		Type:           "mysql",
+		Description:    "World's most popular open source database with proven reliability and performance.",
+		DefaultVersion: "8.0",
+		DefaultPort:    3306,
+		Versions:       []string{"8.0", "8.4"},
+	},
+	{
+		ID:             2,
+		Name:           "PostgreSQL",
+		Type:           "postgresql",
+		Description:    "Advanced open source relational database with powerful features and reliability.",
+		DefaultVersion: "16",
+		DefaultPort:    5432,
+		Versions:       []string{"15", "16", "17", "18"},
+	},
+	{
+		ID:             3,
+		Name:           "MariaDB",
+		Type:           "mariadb",
+		Description:    "MySQL-compatible database with enhanced features, performance and modern architecture.",
+		DefaultVersion: "11.4",
+		DefaultPort:    3306,
+		Versions:       []string{"10.11", "11.4"},
+	},
+}
+
+// DatabaseEngineByType returns the engine with the given type, e.g. "mysql".
+func DatabaseEngineByType(engineType string) (DatabaseEngine, bool) {
+	for _, engine := range DatabaseEngines {
+		if engine.Type == engineType {
+			return engine, true
+		}
+	}
+	return DatabaseEngine{}, false
+}
+
+// versionIndex returns the position of version in e.Versions, or -1. A
+// patch-level version such as "16.4" matches its release series "16".
+func (e DatabaseEngine) versionIndex(version string) int {
+	for i, v := range e.Versions {
+		if version == v || strings.HasPrefix(version, v+".") {
+			return i
+		}
+	}
+	return -1
+}
+
+// SameReleaseSeries reports whether two versions of an engine belong to the
+// same release series, e.g. "16" and "16.4".
+func SameReleaseSeries(engineType, a, b string) bool {
+	engine, ok := DatabaseEngineByType(engineType)
+	if !ok {
+		return a == b
+	}
+	i := engine.versionIndex(a)
+	return i >= 0 && i == engine.versionIndex(b)
+}
+
+// ValidateDatabaseUpgrade reports why a database of the given engine cannot be
+// upgraded from one version to another, or nil if it can.
+func ValidateDatabaseUpgrade(engineType, from, to string) error {
+	engine, ok := DatabaseEngineByType(engineType)
+	if !ok {
+		return fmt.Errorf("unknown engine %q", engineType)
+	}
+
+	target := engine.versionIndex(to)
+	if target < 0 {
+		return fmt.Errorf("%s %s is not offered; supported versions: %s", engine.Name, to, strings.Join(engine.Versions, ", "))
+	}
+
+	current := engine.versionIndex(from)
+	if current < 0 {
+		// The catalog can lag behind the platform; let the API decide.
+		return nil
+	}
+	if target < current {
+		return fmt.Errorf("%s cannot be downgraded from %s to %s; restore a snapshot taken before the upgrade into a new instance instead", engine.Name, from, to)
+	}
+	if target == current {
+		return fmt.Errorf("%s %s and %s are the same release series; minor updates are applied by the platform", engine.Name, from, to)
+	}
+	return nil
+}
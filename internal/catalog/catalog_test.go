@@ -0,0 +1,71 @@
+package catalog
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidateDatabaseUpgrade(t *testing.T) {
+	tests := []struct {
+		name    string
+		engine  string
+		from    string
+		to      string
+		wantErr string
+	}{
+		{name: "postgresql major", engine: "postgresql", from: "15", to: "16"},
+		{name: "postgresql skips majors", engine: "postgresql", from: "15", to: "18"},
+		{name: "patch level current version", engine: "postgresql", from: "15.8", to: "16"},
+		{name: "mysql lts", engine: "mysql", from: "8.0", to: "8.4"},
+		{name: "mariadb", engine: "mariadb", from: "10.11", to: "11.4"},
+		{name: "unknown current version", engine: "mysql", from: "5.7", to: "8.4"},
+		{name: "downgrade", engine: "postgresql", from: "16", to: "15", wantErr: "cannot be downgraded"},
+		{name: "unsupported target", engine: "mysql", from: "8.0", to: "9.1", wantErr: "supported versions: 8.0, 8.4"},
+		{name: "same series", engine: "postgresql", from: "16.2", to: "16", wantErr: "same release series"},
+		{name: "prefix is not a series", engine: "postgresql", from: "15", to: "1", wantErr: "not offered"},
+		{name: "unknown engine", engine: "oracle", from: "19", to: "21", wantErr: "unknown engine"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateDatabaseUpgrade(tt.engine, tt.from, tt.to)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("error = %v, want it to contain %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSameReleaseSeries(t *testing.T) {
+	tests := []struct {
+		engine string
+		a, b   string
+		want   bool
+	}{
+		{engine: "postgresql", a: "16", b: "16.4", want: true},
+		{engine: "mysql", a: "8.0.39", b: "8.0", want: true},
+		{engine: "mysql", a: "8.0", b: "8.4"},
+		{engine: "postgresql", a: "9.6", b: "9.6.1"},
+		{engine: "oracle", a: "19", b: "19", want: true},
+	}
+
+	for _, tt := range tests {
+		if got := SameReleaseSeries(tt.engine, tt.a, tt.b); got != tt.want {
+			t.Errorf("SameReleaseSeries(%q, %q, %q) = %v, want %v", tt.engine, tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestDatabaseEnginesDefaultVersionIsSupported(t *testing.T) {
+	for _, engine := range DatabaseEngines {
+		if engine.versionIndex(engine.DefaultVersion) < 0 {
+			t.Errorf("%s default version %s is not in %v", engine.Type, engine.DefaultVersion, engine.Versions)
+		}
+	}
+}
@@ -16,29 +16,32 @@ type CacheProvider struct {
 
 // CacheInstance represents a cache instance from the API
 type CacheInstance struct {
-	ID                 string        `json:"id"`
-	Name               string        `json:"name"`
-	Status             string        `json:"status"`
-	StatusLabel        string        `json:"status_label"`
-	ResourceProfile    string        `json:"resource_profile"`
-	CPUCores           int           `json:"cpu_cores"`
-	MemorySizeMB       int           `json:"memory_size_mb"`
-	Version            string        `json:"version"`
-	Provider           CacheProvider `json:"provider"`
-	Datacenter         string        `json:"datacenter"`
-	Endpoint           *string       `json:"endpoint"`
-	Port               *int          `json:"port"`
-	ParameterGroupID   *string       `json:"parameter_group_id"`
-	MonthlyCostCents   int           `json:"monthly_cost_cents"`
-	MonthlyCostDollars float64       `json:"monthly_cost_dollars"`
-	DeployedAt         *string       `json:"deployed_at"`
-	CreatedAt          string        `json:"created_at"`
-	UpdatedAt          string        `json:"updated_at"`
-	TeamID             int           `json:"team_id"`
-	UserID             int           `json:"user_id"`
-	CanBeStarted       bool          `json:"can_be_started"`
-	CanBeStopped       bool          `json:"can_be_stopped"`
-	CanBeDestroyed     bool          `json:"can_be_destroyed"`
+	ID                 string             `json:"id"`
+	Name               string             `json:"name"`
+	Status             string             `json:"status"`
+	StatusLabel        string             `json:"status_label"`
+	ResourceProfile    string             `json:"resource_profile"`
+	CPUCores           int                `json:"cpu_cores"`
+	MemorySizeMB       int                `json:"memory_size_mb"`
+	Version            string             `json:"version"`
+	Provider           CacheProvider      `json:"provider"`
+	Datacenter         string             `json:"datacenter"`
+	Endpoint           *string            `json:"endpoint"`
+	Port               *int               `json:"port"`
+	TLSMode            string             `json:"tls_mode"`
+	ParameterGroupID   *string            `json:"parameter_group_id"`
+	MaintenanceWindow  *MaintenanceWindow `json:"maintenance_window"`
+	PendingChanges     *PendingChanges    `json:"pending_changes"`
+	MonthlyCostCents   int                `json:"monthly_cost_cents"`
+	MonthlyCostDollars float64            `json:"monthly_cost_dollars"`
+	DeployedAt         *string            `json:"deployed_at"`
+	CreatedAt          string             `json:"created_at"`
+	UpdatedAt          string             `json:"updated_at"`
+	TeamID             int                `json:"team_id"`
+	UserID             int                `json:"user_id"`
+	CanBeStarted       bool               `json:"can_be_started"`
+	CanBeStopped       bool               `json:"can_be_stopped"`
+	CanBeDestroyed     bool               `json:"can_be_destroyed"`
 }
 
 // Provider represents a cache/database provider
@@ -50,19 +53,26 @@ type Provider struct {
 
 // CreateCacheRequest represents a request to create a cache instance
 type CreateCacheRequest struct {
-	Name             string  `json:"name"`
-	Provider         string  `json:"provider"` // redis, valkey, dragonfly
-	Version          string  `json:"version,omitempty"`
-	Datacenter       string  `json:"datacenter"`
-	ResourceProfile  string  `json:"resource_profile"`
-	ParameterGroupID *string `json:"parameter_group_id,omitempty"`
+	Name              string             `json:"name"`
+	Provider          string             `json:"provider"` // redis, valkey, dragonfly
+	Version           string             `json:"version,omitempty"`
+	Datacenter        string             `json:"datacenter"`
+	ResourceProfile   string             `json:"resource_profile"`
+	ParameterGroupID  *string            `json:"parameter_group_id,omitempty"`
+	MaintenanceWindow *MaintenanceWindow `json:"maintenance_window,omitempty"`
 }
 
 // UpdateCacheRequest represents a request to update a cache instance
 type UpdateCacheRequest struct {
-	Name             string  `json:"name,omitempty"`
-	ResourceProfile  string  `json:"resource_profile,omitempty"`
-	ParameterGroupID *string `json:"parameter_group_id,omitempty"`
+	Name              string             `json:"name,omitempty"`
+	ResourceProfile   string             `json:"resource_profile,omitempty"`
+	ParameterGroupID  *string            `json:"parameter_group_id,omitempty"`
+	MaintenanceWindow *MaintenanceWindow `json:"maintenance_window,omitempty"`
+
+	// ApplyImmediately applies resource_profile and parameter_group_id
+	// changes now. When false they are queued for the maintenance window and
+	// reported in PendingChanges.
+	ApplyImmediately *bool `json:"apply_immediately,omitempty"`
 }
 
 type createCacheResponse struct {
@@ -52,15 +52,21 @@ func (c *Client) doRequest(ctx context.Context, method, path string, body interf
 		bodyReader = bytes.NewReader(jsonBody)
 	}
 
+	return c.doRawRequest(ctx, method, path, "application/json", bodyReader, result)
+}
+
+// doRawRequest sends a pre-encoded body with the given content type, e.g. a
+// multipart upload. The response is decoded as JSON like doRequest.
+func (c *Client) doRawRequest(ctx context.Context, method, path, contentType string, body io.Reader, result interface{}) error {
 	url := fmt.Sprintf("%s%s", c.baseURL, path)
-	req, err := http.NewRequestWithContext(ctx, method, url, bodyReader)
+	req, err := http.NewRequestWithContext(ctx, method, url, body)
 	if err != nil {
 		return fmt.Errorf("failed to create request: %w", err)
 	}
 
 	req.Header.Set("Authorization", "Bearer "+c.apiToken)
 	req.Header.Set("Accept", "application/json")
-	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Content-Type", contentType)
 	if c.userAgent != "" {
 		req.Header.Set("User-Agent", c.userAgent)
 	}
@@ -0,0 +1,52 @@
+package client
+
+import (
+	"context"
+	"fmt"
+)
+
+// RotateCredentialsRequest is the payload for rotating the password of a
+// database or cache instance.
+type RotateCredentialsRequest struct {
+	// GracePeriodMinutes keeps the previous password valid for this long after
+	// the rotation, so clients can switch without downtime. Zero revokes it
+	// immediately.
+	GracePeriodMinutes int `json:"grace_period_minutes"`
+}
+
+// RotatedCredentials holds the credentials of an instance after a rotation.
+// Username is empty for caches.
+type RotatedCredentials struct {
+	ConnectionInfo string `json:"connection_info"`
+	Username       string `json:"username,omitempty"`
+	Password       string `json:"password"`
+	RotatedAt      string `json:"rotated_at"`
+
+	// PreviousPasswordExpiresAt is when the previous password stops working.
+	// Nil when it was revoked immediately.
+	PreviousPasswordExpiresAt *string `json:"previous_password_expires_at"`
+}
+
+type rotateCredentialsResponse struct {
+	Message string             `json:"message"`
+	Data    RotatedCredentials `json:"data"`
+}
+
+// RotateDatabaseCredentials generates a new password for the default user of
+// a database instance.
+func (c *Client) RotateDatabaseCredentials(ctx context.Context, id string, req RotateCredentialsRequest) (*RotatedCredentials, error) {
+	var resp rotateCredentialsResponse
+	if err := c.doRequest(ctx, "POST", fmt.Sprintf("/database/%s/credentials/rotate", id), req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.Data, nil
+}
+
+// RotateCacheCredentials generates a new password for a cache instance.
+func (c *Client) RotateCacheCredentials(ctx context.Context, id string, req RotateCredentialsRequest) (*RotatedCredentials, error) {
+	var resp rotateCredentialsResponse
+	if err := c.doRequest(ctx, "POST", fmt.Sprintf("/cache/%s/credentials/rotate", id), req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.Data, nil
+}
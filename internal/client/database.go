@@ -15,52 +15,93 @@ type DatabaseEngine struct {
 
 // DatabaseInstance represents a database instance from the API
 type DatabaseInstance struct {
-	ID                 string         `json:"id"`
-	Name               string         `json:"name"`
-	Status             string         `json:"status"`
-	StatusLabel        string         `json:"status_label"`
-	ResourceProfile    string         `json:"resource_profile"`
-	CPUCores           int            `json:"cpu_cores"`
-	MemorySizeMB       int            `json:"memory_size_mb"`
-	StorageSizeGB      int            `json:"storage_size_gb"`
-	DatabaseName       *string        `json:"database_name"`
-	Version            string         `json:"version"`
-	Engine             DatabaseEngine `json:"engine"`
-	Provider           Provider       `json:"provider"`
-	Datacenter         string         `json:"datacenter"`
-	Endpoint           *string        `json:"endpoint"`
-	Port               *int           `json:"port"`
-	Username           *string        `json:"username"`
-	ParameterGroupID   *string        `json:"parameter_group_id"`
-	MonthlyCostCents   int            `json:"monthly_cost_cents"`
-	MonthlyCostDollars float64        `json:"monthly_cost_dollars"`
-	DeployedAt         *string        `json:"deployed_at"`
-	CreatedAt          string         `json:"created_at"`
-	UpdatedAt          string         `json:"updated_at"`
-	TeamID             int            `json:"team_id"`
-	UserID             int            `json:"user_id"`
-	CanBeStarted       bool           `json:"can_be_started"`
-	CanBeStopped       bool           `json:"can_be_stopped"`
-	CanBeDestroyed     bool           `json:"can_be_destroyed"`
+	ID                 string              `json:"id"`
+	Name               string              `json:"name"`
+	Status             string              `json:"status"`
+	StatusLabel        string              `json:"status_label"`
+	ResourceProfile    string              `json:"resource_profile"`
+	CPUCores           int                 `json:"cpu_cores"`
+	MemorySizeMB       int                 `json:"memory_size_mb"`
+	StorageSizeGB      int                 `json:"storage_size_gb"`
+	StorageUsedGB      *float64            `json:"storage_used_gb"`
+	StorageAutoscaling *StorageAutoscaling `json:"storage_autoscaling"`
+	DatabaseName       *string             `json:"database_name"`
+	Version            string              `json:"version"`
+	Engine             DatabaseEngine      `json:"engine"`
+	Provider           Provider            `json:"provider"`
+	Datacenter         string              `json:"datacenter"`
+	Endpoint           *string             `json:"endpoint"`
+	// ReaderEndpoint load-balances reads across the instance's healthy
+	// replicas. Nil while the instance has no replicas.
+	ReaderEndpoint *string `json:"reader_endpoint"`
+	Port           *int    `json:"port"`
+	Username       *string `json:"username"`
+	TLSMode        string  `json:"tls_mode"`
+	// ReadOnly is true while the instance does not accept writes, e.g. while
+	// a promoted replica is still catching up.
+	ReadOnly           bool               `json:"read_only"`
+	ParameterGroupID   *string            `json:"parameter_group_id"`
+	MaintenanceWindow  *MaintenanceWindow `json:"maintenance_window"`
+	PendingChanges     *PendingChanges    `json:"pending_changes"`
+	MonthlyCostCents   int                `json:"monthly_cost_cents"`
+	MonthlyCostDollars float64            `json:"monthly_cost_dollars"`
+	DeployedAt         *string            `json:"deployed_at"`
+	CreatedAt          string             `json:"created_at"`
+	UpdatedAt          string             `json:"updated_at"`
+	TeamID             int                `json:"team_id"`
+	UserID             int                `json:"user_id"`
+	CanBeStarted       bool               `json:"can_be_started"`
+	CanBeStopped       bool               `json:"can_be_stopped"`
+	CanBeDestroyed     bool               `json:"can_be_destroyed"`
 }
 
 // CreateDatabaseRequest represents a request to create a database instance
 type CreateDatabaseRequest struct {
-	Name             string  `json:"name"`
-	Provider         string  `json:"provider"` // mysql, postgresql, mariadb
-	DatabaseName     string  `json:"database_name,omitempty"`
-	Version          string  `json:"version,omitempty"`
-	Datacenter       string  `json:"datacenter"`
-	ResourceProfile  string  `json:"resource_profile"`
-	ParameterGroupID *string `json:"parameter_group_id,omitempty"`
+	Name               string              `json:"name"`
+	Provider           string              `json:"provider"` // mysql, postgresql, mariadb
+	DatabaseName       string              `json:"database_name,omitempty"`
+	Version            string              `json:"version,omitempty"`
+	Datacenter         string              `json:"datacenter"`
+	ResourceProfile    string              `json:"resource_profile"`
+	ParameterGroupID   *string             `json:"parameter_group_id,omitempty"`
+	MaintenanceWindow  *MaintenanceWindow  `json:"maintenance_window,omitempty"`
+	StorageAutoscaling *StorageAutoscaling `json:"storage_autoscaling,omitempty"`
+
+	// SourceDatabaseID and RestoreToTime create the instance as a
+	// point-in-time copy of another instance's data instead of empty.
+	SourceDatabaseID string `json:"source_database_id,omitempty"`
+	RestoreToTime    string `json:"restore_to_time,omitempty"`
 }
 
 // UpdateDatabaseRequest represents a request to update a database instance
 type UpdateDatabaseRequest struct {
-	Name             string  `json:"name,omitempty"`
-	ResourceProfile  string  `json:"resource_profile,omitempty"`
-	ParameterGroupID *string `json:"parameter_group_id,omitempty"`
-	StorageSizeGB    *int    `json:"storage_size_gb,omitempty"`
+	Name               string              `json:"name,omitempty"`
+	ResourceProfile    string              `json:"resource_profile,omitempty"`
+	ParameterGroupID   *string             `json:"parameter_group_id,omitempty"`
+	MaintenanceWindow  *MaintenanceWindow  `json:"maintenance_window,omitempty"`
+	StorageSizeGB      *int                `json:"storage_size_gb,omitempty"`
+	StorageAutoscaling *StorageAutoscaling `json:"storage_autoscaling,omitempty"`
+
+	// ApplyImmediately applies resource_profile and parameter_group_id
+	// changes now. When false they are queued for the maintenance window and
+	// reported in PendingChanges.
+	ApplyImmediately *bool `json:"apply_immediately,omitempty"`
+}
+
+// StorageAutoscaling configures server-side storage growth of a database
+// instance: once usage reaches ThresholdPercent of the provisioned size, the
+// platform adds IncrementGB, up to MaxStorageGB.
+type StorageAutoscaling struct {
+	Enabled          bool `json:"enabled"`
+	MaxStorageGB     int  `json:"max_storage_gb"`
+	ThresholdPercent int  `json:"threshold_percent"`
+	IncrementGB      int  `json:"increment_gb"`
+}
+
+// UpgradeDatabaseRequest represents a request to upgrade the engine version
+// of a database instance
+type UpgradeDatabaseRequest struct {
+	Version string `json:"version"`
 }
 
 type createDatabaseResponse struct {
@@ -118,6 +159,16 @@ func (c *Client) UpdateDatabase(ctx context.Context, id string, req UpdateDataba
 	return &resp.Instance, nil
 }
 
+// UpgradeDatabase starts an in-place engine version upgrade. The instance
+// leaves the running status while the upgrade runs.
+func (c *Client) UpgradeDatabase(ctx context.Context, id string, req UpgradeDatabaseRequest) (*DatabaseInstance, error) {
+	var resp updateDatabaseResponse
+	if err := c.doRequest(ctx, "POST", fmt.Sprintf("/database/%s/upgrade", id), req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.Instance, nil
+}
+
 // DeleteDatabase deletes a database instance
 func (c *Client) DeleteDatabase(ctx context.Context, id string) error {
 	return c.doRequest(ctx, "DELETE", fmt.Sprintf("/database/%s", id), nil, nil)
@@ -213,6 +264,41 @@ func (c *Client) WaitForDatabaseStatus(ctx context.Context, id string, targetSta
 	}
 }
 
+// WaitForDatabaseUpgrade waits for a database instance to run the target
+// version. Checking the version, not only the status, keeps a poll that lands
+// before the upgrade job has started from returning early. A reported version
+// such as "16.4" satisfies a target of "16".
+func (c *Client) WaitForDatabaseUpgrade(ctx context.Context, id string, version string, timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+	ticker := time.NewTicker(10 * time.Second)
+	defer ticker.Stop()
+
+	for {
+		instance, err := c.GetDatabase(ctx, id)
+		if err != nil {
+			return fmt.Errorf("error checking database status: %w", err)
+		}
+
+		status := strings.ToLower(instance.Status)
+		if status == "error" {
+			return fmt.Errorf("database %s entered error state", id)
+		}
+		upgraded := instance.Version == version || strings.HasPrefix(instance.Version, version+".")
+		if upgraded && status == "running" {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			if time.Now().After(deadline) {
+				return fmt.Errorf("timeout waiting for database %s to run version %s (status %s, version %s)", id, version, instance.Status, instance.Version)
+			}
+		}
+	}
+}
+
 // WaitForDatabaseDeletion waits for a database instance to be deleted
 func (c *Client) WaitForDatabaseDeletion(ctx context.Context, id string, timeout time.Duration) error {
 	deadline := time.Now().Add(timeout)
@@ -0,0 +1,76 @@
+package client
+
+import (
+	"context"
+	"fmt"
+)
+
+// DatabaseBackupConfig is the automated backup schedule of a database instance.
+type DatabaseBackupConfig struct {
+	Enabled bool `json:"enabled"`
+	// Window is the daily UTC window full backups start in, e.g. "03:00-04:00".
+	Window        string `json:"window"`
+	RetentionDays int    `json:"retention_days"`
+	// LogRetentionDays is how long binlogs (MySQL, MariaDB) or WAL
+	// (PostgreSQL) are kept for point-in-time recovery. Zero disables it.
+	LogRetentionDays int `json:"log_retention_days"`
+}
+
+// UpdateDatabaseBackupConfigRequest changes the backup schedule of a database
+// instance. Nil fields are left unchanged.
+type UpdateDatabaseBackupConfigRequest struct {
+	Enabled          *bool   `json:"enabled,omitempty"`
+	Window           *string `json:"window,omitempty"`
+	RetentionDays    *int    `json:"retention_days,omitempty"`
+	LogRetentionDays *int    `json:"log_retention_days,omitempty"`
+}
+
+// DatabaseBackup is an automated backup of a database instance.
+type DatabaseBackup struct {
+	ID          string  `json:"id"`
+	Type        string  `json:"type"` // full, incremental
+	Status      string  `json:"status"`
+	SizeGB      float64 `json:"size_gb"`
+	StartedAt   string  `json:"started_at"`
+	CompletedAt *string `json:"completed_at"`
+}
+
+// DatabaseBackupList lists the automated backups of a database instance and
+// the range of times it can be restored to. The restorable times are nil
+// while there is no completed backup.
+type DatabaseBackupList struct {
+	Backups                []DatabaseBackup `json:"data"`
+	EarliestRestorableTime *string          `json:"earliest_restorable_time"`
+	LatestRestorableTime   *string          `json:"latest_restorable_time"`
+}
+
+type databaseBackupConfigResponse struct {
+	Data DatabaseBackupConfig `json:"data"`
+}
+
+// GetDatabaseBackupConfig retrieves the backup schedule of a database instance.
+func (c *Client) GetDatabaseBackupConfig(ctx context.Context, id string) (*DatabaseBackupConfig, error) {
+	var resp databaseBackupConfigResponse
+	if err := c.doRequest(ctx, "GET", fmt.Sprintf("/database/%s/backup-config", id), nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.Data, nil
+}
+
+// UpdateDatabaseBackupConfig changes the backup schedule of a database instance.
+func (c *Client) UpdateDatabaseBackupConfig(ctx context.Context, id string, req UpdateDatabaseBackupConfigRequest) (*DatabaseBackupConfig, error) {
+	var resp databaseBackupConfigResponse
+	if err := c.doRequest(ctx, "PUT", fmt.Sprintf("/database/%s/backup-config", id), req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.Data, nil
+}
+
+// ListDatabaseBackups lists the automated backups of a database instance.
+func (c *Client) ListDatabaseBackups(ctx context.Context, id string) (*DatabaseBackupList, error) {
+	var resp DatabaseBackupList
+	if err := c.doRequest(ctx, "GET", fmt.Sprintf("/database/%s/backups", id), nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
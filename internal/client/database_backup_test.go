@@ -0,0 +1,108 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestClient_GetDatabaseBackupConfig(t *testing.T) {
+	server := newTestServer(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" {
+			t.Errorf("Method = %v, want GET", r.Method)
+		}
+		if r.URL.Path != "/database/db-123/backup-config" {
+			t.Errorf("Path = %v, want /database/db-123/backup-config", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":{"enabled":true,"window":"03:00-04:00","retention_days":7,"log_retention_days":3}}`))
+	})
+	defer server.Close()
+
+	c := newTestClient(server)
+	config, err := c.GetDatabaseBackupConfig(context.Background(), "db-123")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !config.Enabled || config.Window != "03:00-04:00" || config.RetentionDays != 7 || config.LogRetentionDays != 3 {
+		t.Errorf("config = %+v, want enabled 03:00-04:00 7/3", config)
+	}
+}
+
+func TestClient_UpdateDatabaseBackupConfig(t *testing.T) {
+	server := newTestServer(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "PUT" {
+			t.Errorf("Method = %v, want PUT", r.Method)
+		}
+
+		var body map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+		if _, ok := body["window"]; ok {
+			t.Errorf("window should be omitted when nil, got %v", body["window"])
+		}
+		if body["retention_days"] != float64(14) {
+			t.Errorf("retention_days = %v, want 14", body["retention_days"])
+		}
+		if body["log_retention_days"] != float64(0) {
+			t.Errorf("log_retention_days = %v, want 0 to be sent", body["log_retention_days"])
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(databaseBackupConfigResponse{
+			Data: DatabaseBackupConfig{Enabled: true, Window: "03:00-04:00", RetentionDays: 14},
+		})
+	})
+	defer server.Close()
+
+	retention, logRetention := 14, 0
+	c := newTestClient(server)
+	config, err := c.UpdateDatabaseBackupConfig(context.Background(), "db-123", UpdateDatabaseBackupConfigRequest{
+		RetentionDays:    &retention,
+		LogRetentionDays: &logRetention,
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if config.RetentionDays != 14 {
+		t.Errorf("RetentionDays = %v, want 14", config.RetentionDays)
+	}
+}
+
+func TestClient_ListDatabaseBackups(t *testing.T) {
+	server := newTestServer(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/database/db-123/backups" {
+			t.Errorf("Path = %v, want /database/db-123/backups", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{
+			"data": [
+				{"id": "bk-1", "type": "full", "status": "completed", "size_gb": 1.5, "started_at": "2026-10-01T03:00:00Z", "completed_at": "2026-10-01T03:12:00Z"},
+				{"id": "bk-2", "type": "full", "status": "running", "size_gb": 0, "started_at": "2026-10-02T03:00:00Z", "completed_at": null}
+			],
+			"earliest_restorable_time": "2026-10-01T03:12:00Z",
+			"latest_restorable_time": "2026-10-02T08:30:00Z"
+		}`))
+	})
+	defer server.Close()
+
+	c := newTestClient(server)
+	list, err := c.ListDatabaseBackups(context.Background(), "db-123")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(list.Backups) != 2 {
+		t.Fatalf("len(Backups) = %v, want 2", len(list.Backups))
+	}
+	if list.Backups[1].CompletedAt != nil {
+		t.Errorf("CompletedAt = %v, want nil for a running backup", *list.Backups[1].CompletedAt)
+	}
+	if list.EarliestRestorableTime == nil || *list.EarliestRestorableTime != "2026-10-01T03:12:00Z" {
+		t.Errorf("EarliestRestorableTime = %v, want 2026-10-01T03:12:00Z", list.EarliestRestorableTime)
+	}
+}
@@ -3,6 +3,7 @@ package client
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 )
 
@@ -43,6 +44,18 @@ type AddDatabaseReplicasRequest struct {
 	ReplicaCount int `json:"replica_count"`
 }
 
+// PromoteDatabaseReplicaRequest is the payload for promoting a replica to a
+// standalone instance.
+type PromoteDatabaseReplicaRequest struct {
+	// Name of the new instance. Defaults to the replica's name.
+	Name string `json:"name,omitempty"`
+}
+
+type promoteDatabaseReplicaResponse struct {
+	Message  string           `json:"message"`
+	Instance DatabaseInstance `json:"instance"`
+}
+
 type addDatabaseReplicasResponse struct {
 	Message  string            `json:"message"`
 	Replicas []DatabaseReplica `json:"replicas"`
@@ -71,6 +84,18 @@ func (c *Client) DeleteDatabaseReplica(ctx context.Context, instanceID string, r
 	return c.doRequest(ctx, "DELETE", fmt.Sprintf("/database/%s/replicas/%d", instanceID, replicaIndex), nil, nil)
 }
 
+// PromoteDatabaseReplica detaches the replica at the given index from its
+// primary and turns it into a standalone instance, which it returns. The
+// instance stops replicating at once but may not accept writes until
+// WaitForDatabaseWritable returns.
+func (c *Client) PromoteDatabaseReplica(ctx context.Context, instanceID string, replicaIndex int, req PromoteDatabaseReplicaRequest) (*DatabaseInstance, error) {
+	var resp promoteDatabaseReplicaResponse
+	if err := c.doRequest(ctx, "POST", fmt.Sprintf("/database/%s/replicas/%d/promote", instanceID, replicaIndex), req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.Instance, nil
+}
+
 // FindDatabaseReplica returns the replica at the given index (or NotFoundError).
 func (c *Client) FindDatabaseReplica(ctx context.Context, instanceID string, replicaIndex int) (*DatabaseReplica, error) {
 	list, err := c.ListDatabaseReplicas(ctx, instanceID)
@@ -115,3 +140,78 @@ func (c *Client) WaitForDatabaseReplicaReady(ctx context.Context, instanceID str
 		}
 	}
 }
+
+// WaitForDatabaseReplicaInSync waits for a ready replica to report healthy
+// replication with a lag of at most maxLagSeconds.
+func (c *Client) WaitForDatabaseReplicaInSync(ctx context.Context, instanceID string, replicaIndex int, maxLagSeconds int, timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+	ticker := time.NewTicker(10 * time.Second)
+	defer ticker.Stop()
+
+	for {
+		replica, err := c.FindDatabaseReplica(ctx, instanceID, replicaIndex)
+		if err != nil {
+			return fmt.Errorf("error checking database replica replication: %w", err)
+		}
+		if replica.Status == "error" || replica.Status == "failed" {
+			return fmt.Errorf("database replica %s:%d entered error state", instanceID, replicaIndex)
+		}
+		if replicaInSync(replica, maxLagSeconds) {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			if time.Now().After(deadline) {
+				lag := "unknown"
+				if replica.SecondsBehindMaster != nil {
+					lag = fmt.Sprintf("%ds", *replica.SecondsBehindMaster)
+				}
+				return fmt.Errorf("timeout waiting for database replica %s:%d to catch up to %ds of lag (lag %s, healthy %t)",
+					instanceID, replicaIndex, maxLagSeconds, lag, replica.IsReplicationHealthy)
+			}
+		}
+	}
+}
+
+// replicaInSync reports whether a replica replicates healthily with a known
+// lag of at most maxLagSeconds.
+func replicaInSync(replica *DatabaseReplica, maxLagSeconds int) bool {
+	return replica.Ready && replica.IsReplicationHealthy &&
+		replica.SecondsBehindMaster != nil && *replica.SecondsBehindMaster <= maxLagSeconds
+}
+
+// WaitForDatabaseWritable waits for a database instance, typically a promoted
+// replica, to be running and accept writes.
+func (c *Client) WaitForDatabaseWritable(ctx context.Context, id string, timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+	ticker := time.NewTicker(10 * time.Second)
+	defer ticker.Stop()
+
+	for {
+		instance, err := c.GetDatabase(ctx, id)
+		if err != nil && !IsNotFound(err) {
+			return fmt.Errorf("error checking database status: %w", err)
+		}
+		if err == nil {
+			status := strings.ToLower(instance.Status)
+			if status == "error" {
+				return fmt.Errorf("database %s entered error state", id)
+			}
+			if status == "running" && !instance.ReadOnly {
+				return nil
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			if time.Now().After(deadline) {
+				return fmt.Errorf("timeout waiting for database %s to accept writes", id)
+			}
+		}
+	}
+}
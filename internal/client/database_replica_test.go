@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"net/http"
 	"testing"
+	"time"
 )
 
 func TestClient_ListDatabaseReplicas(t *testing.T) {
@@ -113,3 +114,126 @@ func TestClient_DeleteDatabaseReplica(t *testing.T) {
 		t.Fatalf("unexpected error: %v", err)
 	}
 }
+
+func TestClient_PromoteDatabaseReplica(t *testing.T) {
+	server := newTestServer(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" {
+			t.Errorf("Method = %v, want POST", r.Method)
+		}
+		if r.URL.Path != "/database/db-123/replicas/1/promote" {
+			t.Errorf("Path = %v, want /database/db-123/replicas/1/promote", r.URL.Path)
+		}
+
+		var req PromoteDatabaseReplicaRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+		if req.Name != "db-dr" {
+			t.Errorf("Name = %v, want db-dr", req.Name)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(promoteDatabaseReplicaResponse{
+			Message:  "Replica promoted",
+			Instance: DatabaseInstance{ID: "db-456", Name: "db-dr", Status: "running", ReadOnly: true},
+		})
+	})
+	defer server.Close()
+
+	c := newTestClient(server)
+	promoted, err := c.PromoteDatabaseReplica(context.Background(), "db-123", 1, PromoteDatabaseReplicaRequest{Name: "db-dr"})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if promoted.ID != "db-456" {
+		t.Errorf("ID = %v, want db-456", promoted.ID)
+	}
+	if !promoted.ReadOnly {
+		t.Error("ReadOnly = false, want true")
+	}
+}
+
+func TestClient_WaitForDatabaseWritable(t *testing.T) {
+	tests := []struct {
+		name     string
+		status   string
+		readOnly bool
+		wantErr  bool
+	}{
+		{name: "writable", status: "running"},
+		{name: "error state", status: "error", readOnly: true, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newTestServer(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				_ = json.NewEncoder(w).Encode(showDatabaseResponse{
+					Instance: DatabaseInstance{ID: "db-456", Status: tt.status, ReadOnly: tt.readOnly},
+				})
+			})
+			defer server.Close()
+
+			c := newTestClient(server)
+			err := c.WaitForDatabaseWritable(context.Background(), "db-456", time.Minute)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("WaitForDatabaseWritable() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestClient_WaitForDatabaseReplicaInSync(t *testing.T) {
+	lag := func(seconds int) *int { return &seconds }
+
+	tests := []struct {
+		name    string
+		replica DatabaseReplica
+		wantErr bool
+	}{
+		{name: "caught up", replica: DatabaseReplica{ReplicaIndex: 1, Status: "running", Ready: true, IsReplicationHealthy: true, SecondsBehindMaster: lag(3)}},
+		{name: "error state", replica: DatabaseReplica{ReplicaIndex: 1, Status: "error", SecondsBehindMaster: lag(300)}, wantErr: true},
+		{name: "replica gone", replica: DatabaseReplica{ReplicaIndex: 2}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newTestServer(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				_ = json.NewEncoder(w).Encode(DatabaseReplicaList{Replicas: []DatabaseReplica{tt.replica}})
+			})
+			defer server.Close()
+
+			c := newTestClient(server)
+			err := c.WaitForDatabaseReplicaInSync(context.Background(), "db-123", 1, 5, time.Minute)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("WaitForDatabaseReplicaInSync() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestReplicaInSync(t *testing.T) {
+	lag := func(seconds int) *int { return &seconds }
+
+	tests := []struct {
+		name    string
+		replica DatabaseReplica
+		want    bool
+	}{
+		{name: "within limit", replica: DatabaseReplica{Ready: true, IsReplicationHealthy: true, SecondsBehindMaster: lag(5)}, want: true},
+		{name: "lagging", replica: DatabaseReplica{Ready: true, IsReplicationHealthy: true, SecondsBehindMaster: lag(6)}},
+		{name: "lag unknown", replica: DatabaseReplica{Ready: true, IsReplicationHealthy: true}},
+		{name: "unhealthy", replica: DatabaseReplica{Ready: true, SecondsBehindMaster: lag(0)}},
+		{name: "not ready", replica: DatabaseReplica{IsReplicationHealthy: true, SecondsBehindMaster: lag(0)}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := replicaInSync(&tt.replica, 5); got != tt.want {
+				t.Errorf("replicaInSync() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
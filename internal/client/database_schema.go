@@ -0,0 +1,68 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// DatabaseSchema represents a logical database on a database instance.
+// Charset and Collation apply to MySQL and MariaDB, Owner to PostgreSQL.
+type DatabaseSchema struct {
+	Name      string `json:"name"`
+	Charset   string `json:"charset"`
+	Collation string `json:"collation"`
+	Owner     string `json:"owner"`
+	CreatedAt string `json:"created_at"`
+}
+
+// DatabaseSchemaRequest is the payload for creating or updating a logical
+// database. Name is ignored on update; empty fields keep the engine default.
+type DatabaseSchemaRequest struct {
+	Name      string `json:"name,omitempty"`
+	Charset   string `json:"charset,omitempty"`
+	Collation string `json:"collation,omitempty"`
+	Owner     string `json:"owner,omitempty"`
+}
+
+type databaseSchemaResponse struct {
+	Message string         `json:"message"`
+	Data    DatabaseSchema `json:"data"`
+}
+
+func databaseSchemaPath(instanceID, name string) string {
+	return fmt.Sprintf("/database/%s/schemas/%s", instanceID, url.PathEscape(name))
+}
+
+// CreateDatabaseSchema creates a logical database on a database instance.
+func (c *Client) CreateDatabaseSchema(ctx context.Context, instanceID string, req DatabaseSchemaRequest) (*DatabaseSchema, error) {
+	var resp databaseSchemaResponse
+	if err := c.doRequest(ctx, "POST", fmt.Sprintf("/database/%s/schemas", instanceID), req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.Data, nil
+}
+
+// GetDatabaseSchema retrieves a logical database by name.
+func (c *Client) GetDatabaseSchema(ctx context.Context, instanceID, name string) (*DatabaseSchema, error) {
+	var resp databaseSchemaResponse
+	if err := c.doRequest(ctx, "GET", databaseSchemaPath(instanceID, name), nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.Data, nil
+}
+
+// UpdateDatabaseSchema changes the charset and collation (MySQL, MariaDB) or
+// the owner (PostgreSQL) of a logical database.
+func (c *Client) UpdateDatabaseSchema(ctx context.Context, instanceID, name string, req DatabaseSchemaRequest) (*DatabaseSchema, error) {
+	var resp databaseSchemaResponse
+	if err := c.doRequest(ctx, "PUT", databaseSchemaPath(instanceID, name), req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.Data, nil
+}
+
+// DeleteDatabaseSchema drops a logical database and all data in it.
+func (c *Client) DeleteDatabaseSchema(ctx context.Context, instanceID, name string) error {
+	return c.doRequest(ctx, "DELETE", databaseSchemaPath(instanceID, name), nil, nil)
+}
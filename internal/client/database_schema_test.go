@@ -0,0 +1,67 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestClient_CreateDatabaseSchema(t *testing.T) {
+	server := newTestServer(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" {
+			t.Errorf("Method = %v, want POST", r.Method)
+		}
+		if r.URL.Path != "/database/db-123/schemas" {
+			t.Errorf("Path = %v, want /database/db-123/schemas", r.URL.Path)
+		}
+
+		var req DatabaseSchemaRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+		if req.Name != "orders" || req.Charset != "utf8mb4" || req.Collation != "utf8mb4_unicode_ci" {
+			t.Errorf("request = %+v, want orders/utf8mb4/utf8mb4_unicode_ci", req)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(databaseSchemaResponse{
+			Message: "Database created",
+			Data:    DatabaseSchema{Name: req.Name, Charset: req.Charset, Collation: req.Collation},
+		})
+	})
+	defer server.Close()
+
+	c := newTestClient(server)
+	schema, err := c.CreateDatabaseSchema(context.Background(), "db-123", DatabaseSchemaRequest{
+		Name:      "orders",
+		Charset:   "utf8mb4",
+		Collation: "utf8mb4_unicode_ci",
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if schema.Collation != "utf8mb4_unicode_ci" {
+		t.Errorf("Collation = %v, want utf8mb4_unicode_ci", schema.Collation)
+	}
+}
+
+func TestClient_DeleteDatabaseSchema(t *testing.T) {
+	server := newTestServer(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "DELETE" {
+			t.Errorf("Method = %v, want DELETE", r.Method)
+		}
+		if r.URL.Path != "/database/db-123/schemas/orders" {
+			t.Errorf("Path = %v, want /database/db-123/schemas/orders", r.URL.Path)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+	defer server.Close()
+
+	c := newTestClient(server)
+	if err := c.DeleteDatabaseSchema(context.Background(), "db-123", "orders"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
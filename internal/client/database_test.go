@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"strings"
 	"testing"
+	"time"
 )
 
 func TestClient_CreateDatabase(t *testing.T) {
@@ -210,6 +211,121 @@ func TestClient_UpdateDatabase(t *testing.T) {
 	}
 }
 
+func TestClient_UpgradeDatabase(t *testing.T) {
+	server := newTestServer(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" {
+			t.Errorf("Method = %v, want POST", r.Method)
+		}
+		if r.URL.Path != "/database/db-123/upgrade" {
+			t.Errorf("Path = %v, want /database/db-123/upgrade", r.URL.Path)
+		}
+
+		var req UpgradeDatabaseRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+		if req.Version != "16" {
+			t.Errorf("Version = %v, want 16", req.Version)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(updateDatabaseResponse{
+			Message:  "Upgrade started",
+			Instance: DatabaseInstance{ID: "db-123", Status: "upgrading", Version: "15"},
+		})
+	})
+	defer server.Close()
+
+	c := newTestClient(server)
+	db, err := c.UpgradeDatabase(context.Background(), "db-123", UpgradeDatabaseRequest{Version: "16"})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if db.Status != "upgrading" {
+		t.Errorf("Status = %v, want upgrading", db.Status)
+	}
+}
+
+func TestClient_WaitForDatabaseUpgrade(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  string
+		version string
+		wantErr bool
+	}{
+		{name: "upgraded", status: "running", version: "16"},
+		{name: "patch level", status: "running", version: "16.4"},
+		{name: "error state", status: "error", version: "15", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newTestServer(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				_ = json.NewEncoder(w).Encode(showDatabaseResponse{
+					Instance: DatabaseInstance{ID: "db-123", Status: tt.status, Version: tt.version},
+				})
+			})
+			defer server.Close()
+
+			c := newTestClient(server)
+			err := c.WaitForDatabaseUpgrade(context.Background(), "db-123", "16", time.Minute)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("WaitForDatabaseUpgrade() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestClient_UpdateDatabase_Deferred(t *testing.T) {
+	server := newTestServer(func(w http.ResponseWriter, r *http.Request) {
+		var req UpdateDatabaseRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+		if req.ApplyImmediately == nil || *req.ApplyImmediately {
+			t.Errorf("ApplyImmediately = %v, want false", req.ApplyImmediately)
+		}
+		if req.MaintenanceWindow == nil || req.MaintenanceWindow.DayOfWeek != "sunday" {
+			t.Errorf("MaintenanceWindow = %+v, want sunday", req.MaintenanceWindow)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{
+			"message": "Changes scheduled",
+			"instance": {
+				"id": "db-123",
+				"resource_profile": "small",
+				"maintenance_window": {"day_of_week": "sunday", "start_hour": 3, "duration_hours": 2},
+				"pending_changes": {"resource_profile": "large", "parameter_group_id": null, "scheduled_at": "2026-10-18T03:00:00Z"}
+			}
+		}`))
+	})
+	defer server.Close()
+
+	applyImmediately := false
+	c := newTestClient(server)
+	db, err := c.UpdateDatabase(context.Background(), "db-123", UpdateDatabaseRequest{
+		ResourceProfile:   "large",
+		MaintenanceWindow: &MaintenanceWindow{DayOfWeek: "sunday", StartHour: 3, DurationHours: 2},
+		ApplyImmediately:  &applyImmediately,
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if db.ResourceProfile != "small" {
+		t.Errorf("ResourceProfile = %v, want small until the window", db.ResourceProfile)
+	}
+	if db.PendingChanges == nil || db.PendingChanges.ResourceProfile == nil || *db.PendingChanges.ResourceProfile != "large" {
+		t.Fatalf("PendingChanges = %+v, want resource_profile large", db.PendingChanges)
+	}
+	if db.PendingChanges.ParameterGroupID != nil {
+		t.Errorf("PendingChanges.ParameterGroupID = %v, want nil", *db.PendingChanges.ParameterGroupID)
+	}
+}
+
 func TestClient_UpdateDatabase_StorageSizeGB(t *testing.T) {
 	server := newTestServer(func(w http.ResponseWriter, r *http.Request) {
 		var body map[string]interface{}
@@ -252,6 +368,46 @@ func TestClient_UpdateDatabase_StorageSizeGB(t *testing.T) {
 	}
 }
 
+func TestClient_UpdateDatabase_StorageAutoscaling(t *testing.T) {
+	server := newTestServer(func(w http.ResponseWriter, r *http.Request) {
+		var req UpdateDatabaseRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+		if req.StorageAutoscaling == nil || !req.StorageAutoscaling.Enabled || req.StorageAutoscaling.MaxStorageGB != 200 {
+			t.Errorf("StorageAutoscaling = %+v, want enabled up to 200 GB", req.StorageAutoscaling)
+		}
+
+		usedGB := 41.5
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(updateDatabaseResponse{
+			Message: "Database instance updated",
+			Instance: DatabaseInstance{
+				ID:                 "db-123",
+				StorageSizeGB:      60,
+				StorageUsedGB:      &usedGB,
+				StorageAutoscaling: req.StorageAutoscaling,
+			},
+		})
+	})
+	defer server.Close()
+
+	c := newTestClient(server)
+	db, err := c.UpdateDatabase(context.Background(), "db-123", UpdateDatabaseRequest{
+		StorageAutoscaling: &StorageAutoscaling{Enabled: true, MaxStorageGB: 200, ThresholdPercent: 85, IncrementGB: 20},
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if db.StorageUsedGB == nil || *db.StorageUsedGB != 41.5 {
+		t.Errorf("StorageUsedGB = %v, want 41.5", db.StorageUsedGB)
+	}
+	if db.StorageAutoscaling == nil || db.StorageAutoscaling.IncrementGB != 20 {
+		t.Errorf("StorageAutoscaling = %+v, want increment_gb 20", db.StorageAutoscaling)
+	}
+}
+
 func TestClient_DeleteDatabase(t *testing.T) {
 	server := newTestServer(func(w http.ResponseWriter, r *http.Request) {
 		if r.Method != "DELETE" {
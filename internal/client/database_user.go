@@ -0,0 +1,82 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// DatabaseGrant is a set of privileges of a database user on one logical
+// database of the instance. Database "*" grants on every database.
+type DatabaseGrant struct {
+	Database   string   `json:"database"`
+	Privileges []string `json:"privileges"`
+}
+
+// DatabaseUser represents a user of a database instance.
+type DatabaseUser struct {
+	Name      string          `json:"name"`
+	Grants    []DatabaseGrant `json:"grants"`
+	CreatedAt string          `json:"created_at"`
+	UpdatedAt string          `json:"updated_at"`
+
+	// Password is only returned when the user is created or its password is
+	// changed.
+	Password string `json:"password,omitempty"`
+}
+
+// CreateDatabaseUserRequest is the payload for creating a database user. The
+// API generates a password if Password is empty.
+type CreateDatabaseUserRequest struct {
+	Name     string          `json:"name"`
+	Password string          `json:"password,omitempty"`
+	Grants   []DatabaseGrant `json:"grants"`
+}
+
+// UpdateDatabaseUserRequest is the payload for updating a database user.
+// Grants are always replaced as a whole; a nil Password keeps the password.
+type UpdateDatabaseUserRequest struct {
+	Password *string         `json:"password,omitempty"`
+	Grants   []DatabaseGrant `json:"grants"`
+}
+
+type databaseUserResponse struct {
+	Message string       `json:"message"`
+	Data    DatabaseUser `json:"data"`
+}
+
+func databaseUserPath(instanceID, name string) string {
+	return fmt.Sprintf("/database/%s/users/%s", instanceID, url.PathEscape(name))
+}
+
+// CreateDatabaseUser creates a user on a database instance.
+func (c *Client) CreateDatabaseUser(ctx context.Context, instanceID string, req CreateDatabaseUserRequest) (*DatabaseUser, error) {
+	var resp databaseUserResponse
+	if err := c.doRequest(ctx, "POST", fmt.Sprintf("/database/%s/users", instanceID), req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.Data, nil
+}
+
+// GetDatabaseUser retrieves a user of a database instance by name.
+func (c *Client) GetDatabaseUser(ctx context.Context, instanceID, name string) (*DatabaseUser, error) {
+	var resp databaseUserResponse
+	if err := c.doRequest(ctx, "GET", databaseUserPath(instanceID, name), nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.Data, nil
+}
+
+// UpdateDatabaseUser changes the password or grants of a database user.
+func (c *Client) UpdateDatabaseUser(ctx context.Context, instanceID, name string, req UpdateDatabaseUserRequest) (*DatabaseUser, error) {
+	var resp databaseUserResponse
+	if err := c.doRequest(ctx, "PUT", databaseUserPath(instanceID, name), req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.Data, nil
+}
+
+// DeleteDatabaseUser drops a database user. Objects it owns are not dropped.
+func (c *Client) DeleteDatabaseUser(ctx context.Context, instanceID, name string) error {
+	return c.doRequest(ctx, "DELETE", databaseUserPath(instanceID, name), nil, nil)
+}
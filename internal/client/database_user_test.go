@@ -0,0 +1,119 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestClient_CreateDatabaseUser(t *testing.T) {
+	server := newTestServer(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" {
+			t.Errorf("Method = %v, want POST", r.Method)
+		}
+		if r.URL.Path != "/database/db-123/users" {
+			t.Errorf("Path = %v, want /database/db-123/users", r.URL.Path)
+		}
+
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Fatalf("failed to read request body: %v", err)
+		}
+		if strings.Contains(string(body), "password") {
+			t.Errorf("request body must not contain password when it is generated, got: %s", body)
+		}
+
+		var req CreateDatabaseUserRequest
+		if err := json.Unmarshal(body, &req); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+		if req.Name != "orders" {
+			t.Errorf("Name = %v, want orders", req.Name)
+		}
+		if len(req.Grants) != 1 || req.Grants[0].Database != "orders" {
+			t.Errorf("Grants = %+v, want one grant on orders", req.Grants)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(databaseUserResponse{
+			Message: "User created",
+			Data: DatabaseUser{
+				Name:     "orders",
+				Grants:   req.Grants,
+				Password: "generated-password",
+			},
+		})
+	})
+	defer server.Close()
+
+	c := newTestClient(server)
+	user, err := c.CreateDatabaseUser(context.Background(), "db-123", CreateDatabaseUserRequest{
+		Name:   "orders",
+		Grants: []DatabaseGrant{{Database: "orders", Privileges: []string{"SELECT", "INSERT"}}},
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if user.Password != "generated-password" {
+		t.Errorf("Password = %v, want generated-password", user.Password)
+	}
+}
+
+func TestClient_UpdateDatabaseUser(t *testing.T) {
+	server := newTestServer(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "PUT" {
+			t.Errorf("Method = %v, want PUT", r.Method)
+		}
+		if r.URL.Path != "/database/db-123/users/orders" {
+			t.Errorf("Path = %v, want /database/db-123/users/orders", r.URL.Path)
+		}
+
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Fatalf("failed to read request body: %v", err)
+		}
+		if strings.Contains(string(body), "password") {
+			t.Errorf("request body must not contain password when it is unchanged, got: %s", body)
+		}
+		if !strings.Contains(string(body), `"grants":[]`) {
+			t.Errorf("request body must send an empty grants list, got: %s", body)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(databaseUserResponse{
+			Message: "User updated",
+			Data:    DatabaseUser{Name: "orders"},
+		})
+	})
+	defer server.Close()
+
+	c := newTestClient(server)
+	_, err := c.UpdateDatabaseUser(context.Background(), "db-123", "orders", UpdateDatabaseUserRequest{
+		Grants: []DatabaseGrant{},
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestClient_GetDatabaseUser_NotFound(t *testing.T) {
+	server := newTestServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"message": "User not found"}`))
+	})
+	defer server.Close()
+
+	c := newTestClient(server)
+	_, err := c.GetDatabaseUser(context.Background(), "db-123", "orders")
+
+	if !IsNotFound(err) {
+		t.Errorf("expected not found error, got: %v", err)
+	}
+}
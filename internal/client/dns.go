@@ -5,6 +5,27 @@ import (
 	"fmt"
 )
 
+// DnsStatus is the public DNS exposure of a cache or database instance.
+type DnsStatus struct {
+	Enabled bool `json:"enabled"`
+	// Hostname is the public name of the instance. It is empty while DNS is
+	// disabled and may stay empty for a short time after enabling it.
+	Hostname string `json:"hostname"`
+}
+
+type dnsStatusResponse struct {
+	Data DnsStatus `json:"data"`
+}
+
+// GetCacheDns retrieves the live public DNS state of a cache instance.
+func (c *Client) GetCacheDns(ctx context.Context, id string) (*DnsStatus, error) {
+	var resp dnsStatusResponse
+	if err := c.doRequest(ctx, "GET", fmt.Sprintf("/cache/%s/dns", id), nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.Data, nil
+}
+
 // EnableCacheDns enables public DNS for a cache instance.
 func (c *Client) EnableCacheDns(ctx context.Context, id string) error {
 	return c.doRequest(ctx, "POST", fmt.Sprintf("/cache/%s/dns", id), nil, nil)
@@ -15,6 +36,15 @@ func (c *Client) DisableCacheDns(ctx context.Context, id string) error {
 	return c.doRequest(ctx, "DELETE", fmt.Sprintf("/cache/%s/dns", id), nil, nil)
 }
 
+// GetDatabaseDns retrieves the live public DNS state of a database instance.
+func (c *Client) GetDatabaseDns(ctx context.Context, id string) (*DnsStatus, error) {
+	var resp dnsStatusResponse
+	if err := c.doRequest(ctx, "GET", fmt.Sprintf("/database/%s/dns", id), nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.Data, nil
+}
+
 // EnableDatabaseDns enables public DNS for a database instance.
 func (c *Client) EnableDatabaseDns(ctx context.Context, id string) error {
 	return c.doRequest(ctx, "POST", fmt.Sprintf("/database/%s/dns", id), nil, nil)
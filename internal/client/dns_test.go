@@ -79,3 +79,49 @@ func TestClient_DisableDatabaseDns(t *testing.T) {
 		t.Fatalf("unexpected error: %v", err)
 	}
 }
+
+func TestClient_GetDatabaseDns(t *testing.T) {
+	server := newTestServer(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" {
+			t.Errorf("Method = %v, want GET", r.Method)
+		}
+		if r.URL.Path != "/database/db-123/dns" {
+			t.Errorf("Path = %v, want /database/db-123/dns", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data": {"enabled": true, "hostname": "db-123.db.danubedata.ro"}}`))
+	})
+	defer server.Close()
+
+	c := newTestClient(server)
+	dns, err := c.GetDatabaseDns(context.Background(), "db-123")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !dns.Enabled {
+		t.Error("Enabled = false, want true")
+	}
+	if dns.Hostname != "db-123.db.danubedata.ro" {
+		t.Errorf("Hostname = %v, want db-123.db.danubedata.ro", dns.Hostname)
+	}
+}
+
+func TestClient_GetCacheDns_Disabled(t *testing.T) {
+	server := newTestServer(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/cache/cache-123/dns" {
+			t.Errorf("Path = %v, want /cache/cache-123/dns", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data": {"enabled": false, "hostname": null}}`))
+	})
+	defer server.Close()
+
+	c := newTestClient(server)
+	dns, err := c.GetCacheDns(context.Background(), "cache-123")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if dns.Enabled || dns.Hostname != "" {
+		t.Errorf("DnsStatus = %+v, want disabled without hostname", dns)
+	}
+}
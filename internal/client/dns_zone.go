@@ -0,0 +1,161 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// DNSZone represents a DNS zone hosted on DanubeData's nameservers. The zone
+// only answers queries once the domain's registrar delegates to Nameservers.
+type DNSZone struct {
+	ID          string   `json:"id"`
+	Name        string   `json:"name"`
+	Status      string   `json:"status"` // pending (not delegated yet), active
+	Nameservers []string `json:"nameservers"`
+	RecordCount int      `json:"record_count"`
+	CreatedAt   string   `json:"created_at"`
+	UpdatedAt   string   `json:"updated_at"`
+}
+
+// DNSRecord represents a record in a DNS zone. Name is relative to the zone,
+// with "@" for the zone apex.
+type DNSRecord struct {
+	ID        string `json:"id"`
+	ZoneID    string `json:"zone_id"`
+	Name      string `json:"name"`
+	Type      string `json:"type"` // A, AAAA, CNAME, MX, TXT, SRV, CAA
+	Value     string `json:"value"`
+	TTL       int    `json:"ttl"`
+	Priority  *int   `json:"priority"` // MX and SRV only
+	Weight    *int   `json:"weight"`   // SRV only
+	Port      *int   `json:"port"`     // SRV only
+	FQDN      string `json:"fqdn"`
+	CreatedAt string `json:"created_at"`
+	UpdatedAt string `json:"updated_at"`
+}
+
+// CreateDNSZoneRequest is the payload for creating a DNS zone.
+type CreateDNSZoneRequest struct {
+	Name string `json:"name"`
+}
+
+// DNSRecordRequest is the payload for creating or updating a DNS record. It
+// is always sent in full; Priority, Weight and Port are null for types that do
+// not use them.
+type DNSRecordRequest struct {
+	Name     string `json:"name"`
+	Type     string `json:"type"`
+	Value    string `json:"value"`
+	TTL      int    `json:"ttl"`
+	Priority *int   `json:"priority"`
+	Weight   *int   `json:"weight"`
+	Port     *int   `json:"port"`
+}
+
+type dnsZoneResponse struct {
+	Message string  `json:"message"`
+	Data    DNSZone `json:"data"`
+}
+
+type listDNSZonesResponse struct {
+	Data       []DNSZone  `json:"data"`
+	Pagination Pagination `json:"pagination"`
+}
+
+type dnsRecordResponse struct {
+	Message string    `json:"message"`
+	Data    DNSRecord `json:"data"`
+}
+
+// CreateDNSZone creates a DNS zone.
+func (c *Client) CreateDNSZone(ctx context.Context, req CreateDNSZoneRequest) (*DNSZone, error) {
+	var resp dnsZoneResponse
+	if err := c.doRequest(ctx, "POST", "/dns/zones", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.Data, nil
+}
+
+// GetDNSZone retrieves a DNS zone by ID.
+func (c *Client) GetDNSZone(ctx context.Context, id string) (*DNSZone, error) {
+	var resp dnsZoneResponse
+	if err := c.doRequest(ctx, "GET", fmt.Sprintf("/dns/zones/%s", id), nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.Data, nil
+}
+
+// ListDNSZones retrieves all DNS zones (handles pagination automatically).
+func (c *Client) ListDNSZones(ctx context.Context) ([]DNSZone, error) {
+	var allZones []DNSZone
+	page := 1
+
+	for {
+		var resp listDNSZonesResponse
+		if err := c.doRequest(ctx, "GET", fmt.Sprintf("/dns/zones?page=%d", page), nil, &resp); err != nil {
+			return nil, err
+		}
+		allZones = append(allZones, resp.Data...)
+
+		if page >= resp.Pagination.LastPage || len(resp.Data) == 0 {
+			break
+		}
+		page++
+	}
+	return allZones, nil
+}
+
+// FindDNSZoneByName looks up a DNS zone by its domain name. The comparison
+// ignores case and a trailing dot.
+func (c *Client) FindDNSZoneByName(ctx context.Context, name string) (*DNSZone, error) {
+	zones, err := c.ListDNSZones(ctx)
+	if err != nil {
+		return nil, err
+	}
+	want := strings.TrimSuffix(strings.ToLower(name), ".")
+	for i := range zones {
+		if strings.TrimSuffix(strings.ToLower(zones[i].Name), ".") == want {
+			return &zones[i], nil
+		}
+	}
+	return nil, &NotFoundError{Resource: "DNS zone", ID: name}
+}
+
+// DeleteDNSZone deletes a DNS zone and all of its records.
+func (c *Client) DeleteDNSZone(ctx context.Context, id string) error {
+	return c.doRequest(ctx, "DELETE", fmt.Sprintf("/dns/zones/%s", id), nil, nil)
+}
+
+// CreateDNSRecord adds a record to a DNS zone.
+func (c *Client) CreateDNSRecord(ctx context.Context, zoneID string, req DNSRecordRequest) (*DNSRecord, error) {
+	var resp dnsRecordResponse
+	if err := c.doRequest(ctx, "POST", fmt.Sprintf("/dns/zones/%s/records", zoneID), req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.Data, nil
+}
+
+// GetDNSRecord retrieves a record of a DNS zone.
+func (c *Client) GetDNSRecord(ctx context.Context, zoneID, id string) (*DNSRecord, error) {
+	var resp dnsRecordResponse
+	if err := c.doRequest(ctx, "GET", fmt.Sprintf("/dns/zones/%s/records/%s", zoneID, id), nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.Data, nil
+}
+
+// UpdateDNSRecord replaces a record of a DNS zone. The record type cannot be
+// changed.
+func (c *Client) UpdateDNSRecord(ctx context.Context, zoneID, id string, req DNSRecordRequest) (*DNSRecord, error) {
+	var resp dnsRecordResponse
+	if err := c.doRequest(ctx, "PUT", fmt.Sprintf("/dns/zones/%s/records/%s", zoneID, id), req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.Data, nil
+}
+
+// DeleteDNSRecord removes a record from a DNS zone.
+func (c *Client) DeleteDNSRecord(ctx context.Context, zoneID, id string) error {
+	return c.doRequest(ctx, "DELETE", fmt.Sprintf("/dns/zones/%s/records/%s", zoneID, id), nil, nil)
+}
@@ -0,0 +1,171 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestClient_CreateDNSZone(t *testing.T) {
+	server := newTestServer(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" {
+			t.Errorf("Method = %v, want POST", r.Method)
+		}
+		if r.URL.Path != "/dns/zones" {
+			t.Errorf("Path = %v, want /dns/zones", r.URL.Path)
+		}
+
+		var req CreateDNSZoneRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+		if req.Name != "example.com" {
+			t.Errorf("Name = %v, want example.com", req.Name)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(dnsZoneResponse{
+			Data: DNSZone{
+				ID:          "zone-123",
+				Name:        "example.com",
+				Status:      "pending",
+				Nameservers: []string{"ns1.danubedata.ro", "ns2.danubedata.ro"},
+			},
+		})
+	})
+	defer server.Close()
+
+	c := newTestClient(server)
+	zone, err := c.CreateDNSZone(context.Background(), CreateDNSZoneRequest{Name: "example.com"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if zone.ID != "zone-123" {
+		t.Errorf("ID = %v, want zone-123", zone.ID)
+	}
+	if len(zone.Nameservers) != 2 {
+		t.Errorf("Nameservers = %v, want 2", zone.Nameservers)
+	}
+}
+
+func TestClient_FindDNSZoneByName(t *testing.T) {
+	server := newTestServer(func(w http.ResponseWriter, r *http.Request) {
+		page := r.URL.Query().Get("page")
+		w.Header().Set("Content-Type", "application/json")
+		resp := listDNSZonesResponse{Pagination: Pagination{LastPage: 2}}
+		if page == "1" {
+			resp.Data = []DNSZone{{ID: "zone-1", Name: "example.org"}}
+		} else {
+			resp.Data = []DNSZone{{ID: "zone-2", Name: "example.com"}}
+		}
+		_ = json.NewEncoder(w).Encode(resp)
+	})
+	defer server.Close()
+
+	c := newTestClient(server)
+	zone, err := c.FindDNSZoneByName(context.Background(), "Example.COM.")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if zone.ID != "zone-2" {
+		t.Errorf("ID = %v, want zone-2", zone.ID)
+	}
+
+	_, err = c.FindDNSZoneByName(context.Background(), "missing.com")
+	if !IsNotFound(err) {
+		t.Errorf("error = %v, want a not found error", err)
+	}
+}
+
+func TestClient_CreateDNSRecord_SendsNullOptionalFields(t *testing.T) {
+	server := newTestServer(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" {
+			t.Errorf("Method = %v, want POST", r.Method)
+		}
+		if r.URL.Path != "/dns/zones/zone-123/records" {
+			t.Errorf("Path = %v, want /dns/zones/zone-123/records", r.URL.Path)
+		}
+
+		var body map[string]json.RawMessage
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+		for _, field := range []string{"priority", "weight", "port"} {
+			if string(body[field]) != "null" {
+				t.Errorf("%s = %s, want null", field, body[field])
+			}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(dnsRecordResponse{
+			Data: DNSRecord{ID: "rec-1", ZoneID: "zone-123", Name: "www", Type: "A", Value: "203.0.113.7", TTL: 300, FQDN: "www.example.com"},
+		})
+	})
+	defer server.Close()
+
+	c := newTestClient(server)
+	record, err := c.CreateDNSRecord(context.Background(), "zone-123", DNSRecordRequest{Name: "www", Type: "A", Value: "203.0.113.7", TTL: 300})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if record.FQDN != "www.example.com" {
+		t.Errorf("FQDN = %v, want www.example.com", record.FQDN)
+	}
+}
+
+func TestClient_UpdateDNSRecord(t *testing.T) {
+	server := newTestServer(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "PUT" {
+			t.Errorf("Method = %v, want PUT", r.Method)
+		}
+		if r.URL.Path != "/dns/zones/zone-123/records/rec-1" {
+			t.Errorf("Path = %v, want /dns/zones/zone-123/records/rec-1", r.URL.Path)
+		}
+
+		var req DNSRecordRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+		if req.Priority == nil || *req.Priority != 10 {
+			t.Errorf("Priority = %v, want 10", req.Priority)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(dnsRecordResponse{
+			Data: DNSRecord{ID: "rec-1", Type: "MX", Value: "mail.example.com.", Priority: req.Priority},
+		})
+	})
+	defer server.Close()
+
+	c := newTestClient(server)
+	priority := 10
+	record, err := c.UpdateDNSRecord(context.Background(), "zone-123", "rec-1", DNSRecordRequest{Name: "@", Type: "MX", Value: "mail.example.com", TTL: 3600, Priority: &priority})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if record.Priority == nil || *record.Priority != 10 {
+		t.Errorf("Priority = %v, want 10", record.Priority)
+	}
+}
+
+func TestClient_DeleteDNSRecord_NotFound(t *testing.T) {
+	server := newTestServer(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "DELETE" {
+			t.Errorf("Method = %v, want DELETE", r.Method)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = fmt.Fprint(w, `{"message": "Record not found"}`)
+	})
+	defer server.Close()
+
+	c := newTestClient(server)
+	err := c.DeleteDNSRecord(context.Background(), "zone-123", "rec-1")
+	if !IsNotFound(err) {
+		t.Errorf("error = %v, want a not found error", err)
+	}
+}
@@ -0,0 +1,66 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// customDomainState is the part of a custom domain waitForCustomDomain needs,
+// shared by static site and serverless domains.
+type customDomainState struct {
+	ID                 string
+	VerificationStatus string
+	TLSStatus          string
+	DNSInstructions    StaticSiteDomainDNSInstructions
+}
+
+// waitForCustomDomain polls a custom domain until it is verified and, when
+// waitForTLS is set, its TLS certificate is active. Verification is
+// re-triggered on every poll while it is pending, since DNS records can take a
+// while to propagate. Errors name the DNS record the domain still needs.
+func waitForCustomDomain(ctx context.Context, domain string, waitForTLS bool, timeout time.Duration,
+	find func(ctx context.Context) (customDomainState, error), verify func(ctx context.Context, id string) error) error {
+	deadline := time.Now().Add(timeout)
+	ticker := time.NewTicker(10 * time.Second)
+	defer ticker.Stop()
+
+	for {
+		d, err := find(ctx)
+		if err != nil {
+			return fmt.Errorf("error checking domain %s: %w", domain, err)
+		}
+
+		switch d.VerificationStatus {
+		case "failed":
+			return fmt.Errorf("verification of domain %s failed; %s", domain, missingDNSRecord(d.DNSInstructions))
+		case "verified":
+			if !waitForTLS || d.TLSStatus == "active" {
+				return nil
+			}
+			if d.TLSStatus == "failed" {
+				return fmt.Errorf("TLS certificate issuance for domain %s failed", domain)
+			}
+		case "pending":
+			if err := verify(ctx, d.ID); err != nil {
+				return fmt.Errorf("error triggering verification of domain %s: %w", domain, err)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			if time.Now().After(deadline) {
+				if d.VerificationStatus != "verified" {
+					return fmt.Errorf("timeout waiting for domain %s to be verified (status %s); %s", domain, d.VerificationStatus, missingDNSRecord(d.DNSInstructions))
+				}
+				return fmt.Errorf("timeout waiting for TLS certificate of domain %s (status %s)", domain, d.TLSStatus)
+			}
+		}
+	}
+}
+
+func missingDNSRecord(dns StaticSiteDomainDNSInstructions) string {
+	return fmt.Sprintf("check that the %s record %s has the value %q", dns.RecordType, dns.RecordName, dns.RecordValue)
+}
@@ -7,14 +7,23 @@ import (
 
 // Firewall represents a firewall from the API
 type Firewall struct {
-	ID          string         `json:"id"`
-	Name        string         `json:"name"`
-	Description string         `json:"description"`
-	Status      string         `json:"status"`
-	Rules       []FirewallRule `json:"rules"`
-	CreatedAt   string         `json:"created_at"`
-	UpdatedAt   string         `json:"updated_at"`
-	TeamID      int            `json:"team_id"`
+	ID                    string               `json:"id"`
+	Name                  string               `json:"name"`
+	Description           string               `json:"description"`
+	Status                string               `json:"status"`
+	DefaultInboundPolicy  string               `json:"default_inbound_policy"`
+	DefaultOutboundPolicy string               `json:"default_outbound_policy"`
+	Rules                 []FirewallRule       `json:"rules"`
+	Attachments           []FirewallAttachment `json:"attachments"`
+	CreatedAt             string               `json:"created_at"`
+	UpdatedAt             string               `json:"updated_at"`
+	TeamID                int                  `json:"team_id"`
+}
+
+// FirewallAttachment identifies an instance a firewall is attached to
+type FirewallAttachment struct {
+	InstanceType string `json:"instance_type"`
+	InstanceID   string `json:"instance_id"`
 }
 
 // FirewallRule represents a firewall rule
@@ -27,14 +36,30 @@ type FirewallRule struct {
 	PortRangeStart *int     `json:"port_range_start"`
 	PortRangeEnd   *int     `json:"port_range_end"`
 	SourceIPs      []string `json:"source_ips"`
+	SourceIPSetIDs []string `json:"source_ip_set_ids"`
+	DestinationIPs []string `json:"destination_ips"`
 	Order          int      `json:"order"`
 }
 
+// ReferencesIPSet reports whether any rule of the firewall references the given IP set.
+func (f *Firewall) ReferencesIPSet(ipSetID string) bool {
+	for _, rule := range f.Rules {
+		for _, id := range rule.SourceIPSetIDs {
+			if id == ipSetID {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 // CreateFirewallRequest represents a request to create a firewall
 type CreateFirewallRequest struct {
-	Name        string                      `json:"name"`
-	Description string                      `json:"description,omitempty"`
-	Rules       []CreateFirewallRuleRequest `json:"rules,omitempty"`
+	Name                  string                      `json:"name"`
+	Description           string                      `json:"description,omitempty"`
+	DefaultInboundPolicy  string                      `json:"default_inbound_policy,omitempty"`
+	DefaultOutboundPolicy string                      `json:"default_outbound_policy,omitempty"`
+	Rules                 []CreateFirewallRuleRequest `json:"rules,omitempty"`
 }
 
 // CreateFirewallRuleRequest represents a rule in a create or update request
@@ -46,14 +71,18 @@ type CreateFirewallRuleRequest struct {
 	PortRangeStart *int     `json:"port_range_start,omitempty"`
 	PortRangeEnd   *int     `json:"port_range_end,omitempty"`
 	SourceIPs      []string `json:"source_ips,omitempty"`
+	SourceIPSetIDs []string `json:"source_ip_set_ids,omitempty"`
+	DestinationIPs []string `json:"destination_ips,omitempty"`
 	Order          int      `json:"order,omitempty"`
 }
 
 // UpdateFirewallRequest represents a request to update a firewall
 type UpdateFirewallRequest struct {
-	Name        string                      `json:"name,omitempty"`
-	Description string                      `json:"description,omitempty"`
-	Rules       []CreateFirewallRuleRequest `json:"rules,omitempty"`
+	Name                  string                      `json:"name,omitempty"`
+	Description           string                      `json:"description,omitempty"`
+	DefaultInboundPolicy  string                      `json:"default_inbound_policy,omitempty"`
+	DefaultOutboundPolicy string                      `json:"default_outbound_policy,omitempty"`
+	Rules                 []CreateFirewallRuleRequest `json:"rules,omitempty"`
 }
 
 // AttachFirewallRequest represents a request to attach a firewall to an instance
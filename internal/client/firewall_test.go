@@ -353,3 +353,60 @@ func TestClient_DeployFirewall(t *testing.T) {
 		t.Fatalf("unexpected error: %v", err)
 	}
 }
+
+func TestClient_CreateFirewall_DefaultPoliciesAndEgress(t *testing.T) {
+	server := newTestServer(func(w http.ResponseWriter, r *http.Request) {
+		var req CreateFirewallRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+
+		if req.DefaultInboundPolicy != "deny" {
+			t.Errorf("DefaultInboundPolicy = %v, want deny", req.DefaultInboundPolicy)
+		}
+		if req.DefaultOutboundPolicy != "deny" {
+			t.Errorf("DefaultOutboundPolicy = %v, want deny", req.DefaultOutboundPolicy)
+		}
+		if len(req.Rules) != 1 || len(req.Rules[0].DestinationIPs) != 1 {
+			t.Fatalf("Rules = %+v, want one rule with one destination", req.Rules)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(createFirewallResponse{
+			Firewall: Firewall{
+				ID:                    "fw-123",
+				DefaultInboundPolicy:  req.DefaultInboundPolicy,
+				DefaultOutboundPolicy: req.DefaultOutboundPolicy,
+				Rules: []FirewallRule{
+					{ID: "rule-1", Action: "allow", Direction: "outbound", Protocol: "tcp", DestinationIPs: req.Rules[0].DestinationIPs},
+				},
+				Attachments: []FirewallAttachment{{InstanceType: "vps", InstanceID: "vps-1"}},
+			},
+		})
+	})
+	defer server.Close()
+
+	c := newTestClient(server)
+	fw, err := c.CreateFirewall(context.Background(), CreateFirewallRequest{
+		Name:                  "egress-locked",
+		DefaultInboundPolicy:  "deny",
+		DefaultOutboundPolicy: "deny",
+		Rules: []CreateFirewallRuleRequest{
+			{Action: "allow", Direction: "outbound", Protocol: "tcp", DestinationIPs: []string{"10.0.0.0/8"}},
+		},
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if fw.DefaultOutboundPolicy != "deny" {
+		t.Errorf("DefaultOutboundPolicy = %v, want deny", fw.DefaultOutboundPolicy)
+	}
+	if fw.Rules[0].DestinationIPs[0] != "10.0.0.0/8" {
+		t.Errorf("Rules[0].DestinationIPs[0] = %v, want 10.0.0.0/8", fw.Rules[0].DestinationIPs[0])
+	}
+	if len(fw.Attachments) != 1 || fw.Attachments[0].InstanceType != "vps" {
+		t.Errorf("Attachments = %+v, want one vps attachment", fw.Attachments)
+	}
+}
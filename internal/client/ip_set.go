@@ -0,0 +1,137 @@
+package client
+
+import (
+	"context"
+	"fmt"
+)
+
+// IPSet represents a reusable, named list of IP addresses and CIDR blocks
+// that firewall rules can reference instead of repeating source_ips.
+type IPSet struct {
+	ID          string   `json:"id"`
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	CIDRs       []string `json:"cidrs"`
+	CreatedAt   string   `json:"created_at"`
+	UpdatedAt   string   `json:"updated_at"`
+	TeamID      int      `json:"team_id"`
+}
+
+// CreateIPSetRequest represents a request to create an IP set
+type CreateIPSetRequest struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description,omitempty"`
+	CIDRs       []string `json:"cidrs"`
+}
+
+// UpdateIPSetRequest represents a request to update an IP set
+type UpdateIPSetRequest struct {
+	Name string `json:"name,omitempty"`
+	// Description is left unchanged when nil; a pointer to "" clears it.
+	Description *string  `json:"description,omitempty"`
+	CIDRs       []string `json:"cidrs,omitempty"`
+}
+
+type createIPSetResponse struct {
+	Message string `json:"message"`
+	IPSet   IPSet  `json:"ip_set"`
+}
+
+type showIPSetResponse struct {
+	IPSet IPSet `json:"ip_set"`
+}
+
+type listIPSetsResponse struct {
+	Data       []IPSet    `json:"data"`
+	Pagination Pagination `json:"pagination"`
+}
+
+// CreateIPSet creates a new IP set
+func (c *Client) CreateIPSet(ctx context.Context, req CreateIPSetRequest) (*IPSet, error) {
+	var resp createIPSetResponse
+	if err := c.doRequest(ctx, "POST", "/ip-sets", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.IPSet, nil
+}
+
+// GetIPSet retrieves an IP set by ID
+func (c *Client) GetIPSet(ctx context.Context, id string) (*IPSet, error) {
+	var resp showIPSetResponse
+	if err := c.doRequest(ctx, "GET", fmt.Sprintf("/ip-sets/%s", id), nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.IPSet, nil
+}
+
+// ListIPSets retrieves all IP sets (handles pagination automatically)
+func (c *Client) ListIPSets(ctx context.Context) ([]IPSet, error) {
+	var allIPSets []IPSet
+	page := 1
+
+	for {
+		var resp listIPSetsResponse
+		if err := c.doRequest(ctx, "GET", fmt.Sprintf("/ip-sets?page=%d", page), nil, &resp); err != nil {
+			return nil, err
+		}
+		allIPSets = append(allIPSets, resp.Data...)
+
+		if page >= resp.Pagination.LastPage || len(resp.Data) == 0 {
+			break
+		}
+		page++
+	}
+	return allIPSets, nil
+}
+
+// UpdateIPSet updates an IP set. The API re-expands the set into every
+// referencing firewall's rules, but those firewalls still need a deploy to
+// push the new addresses to attached instances; see DeployFirewallsUsingIPSet.
+func (c *Client) UpdateIPSet(ctx context.Context, id string, req UpdateIPSetRequest) (*IPSet, error) {
+	var resp showIPSetResponse
+	if err := c.doRequest(ctx, "PUT", fmt.Sprintf("/ip-sets/%s", id), req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.IPSet, nil
+}
+
+// DeleteIPSet deletes an IP set. The API rejects deletion while any firewall
+// rule still references the set.
+func (c *Client) DeleteIPSet(ctx context.Context, id string) error {
+	return c.doRequest(ctx, "DELETE", fmt.Sprintf("/ip-sets/%s", id), nil, nil)
+}
+
+// ListFirewallsUsingIPSet returns every firewall with at least one rule that
+// references the given IP set.
+func (c *Client) ListFirewallsUsingIPSet(ctx context.Context, ipSetID string) ([]Firewall, error) {
+	firewalls, err := c.ListFirewalls(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var using []Firewall
+	for _, fw := range firewalls {
+		if fw.ReferencesIPSet(ipSetID) {
+			using = append(using, fw)
+		}
+	}
+	return using, nil
+}
+
+// DeployFirewallsUsingIPSet redeploys every firewall that references the given
+// IP set and returns the IDs of the firewalls it deployed.
+func (c *Client) DeployFirewallsUsingIPSet(ctx context.Context, ipSetID string) ([]string, error) {
+	firewalls, err := c.ListFirewallsUsingIPSet(ctx, ipSetID)
+	if err != nil {
+		return nil, err
+	}
+
+	deployed := make([]string, 0, len(firewalls))
+	for _, fw := range firewalls {
+		if err := c.DeployFirewall(ctx, fw.ID); err != nil {
+			return deployed, fmt.Errorf("failed to deploy firewall %s: %w", fw.ID, err)
+		}
+		deployed = append(deployed, fw.ID)
+	}
+	return deployed, nil
+}
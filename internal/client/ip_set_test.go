@@ -0,0 +1,173 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestClient_CreateIPSet(t *testing.T) {
+	server := newTestServer(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" {
+			t.Errorf("Method = %v, want POST", r.Method)
+		}
+		if r.URL.Path != "/ip-sets" {
+			t.Errorf("Path = %v, want /ip-sets", r.URL.Path)
+		}
+
+		var req CreateIPSetRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+		if req.Name != "office" {
+			t.Errorf("Name = %v, want office", req.Name)
+		}
+		if len(req.CIDRs) != 2 {
+			t.Errorf("CIDRs count = %v, want 2", len(req.CIDRs))
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(createIPSetResponse{
+			Message: "IP set created",
+			IPSet: IPSet{
+				ID:    "ips-123",
+				Name:  "office",
+				CIDRs: []string{"203.0.113.0/24", "198.51.100.7"},
+			},
+		})
+	})
+	defer server.Close()
+
+	c := newTestClient(server)
+	ipSet, err := c.CreateIPSet(context.Background(), CreateIPSetRequest{
+		Name:  "office",
+		CIDRs: []string{"203.0.113.0/24", "198.51.100.7"},
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if ipSet.ID != "ips-123" {
+		t.Errorf("ID = %v, want ips-123", ipSet.ID)
+	}
+	if len(ipSet.CIDRs) != 2 {
+		t.Errorf("CIDRs count = %v, want 2", len(ipSet.CIDRs))
+	}
+}
+
+func TestClient_GetIPSet_NotFound(t *testing.T) {
+	server := newTestServer(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"message": "IP set not found"}`))
+	})
+	defer server.Close()
+
+	c := newTestClient(server)
+	_, err := c.GetIPSet(context.Background(), "nonexistent")
+
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+	if !IsNotFound(err) {
+		t.Errorf("expected not found error, got %v", err)
+	}
+}
+
+func TestClient_UpdateIPSet(t *testing.T) {
+	server := newTestServer(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "PUT" {
+			t.Errorf("Method = %v, want PUT", r.Method)
+		}
+		if r.URL.Path != "/ip-sets/ips-123" {
+			t.Errorf("Path = %v, want /ip-sets/ips-123", r.URL.Path)
+		}
+
+		var req UpdateIPSetRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+		if len(req.CIDRs) != 1 || req.CIDRs[0] != "192.0.2.0/24" {
+			t.Errorf("CIDRs = %v, want [192.0.2.0/24]", req.CIDRs)
+		}
+		if req.Description == nil || *req.Description != "" {
+			t.Errorf("Description = %v, want an explicit empty string", req.Description)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(showIPSetResponse{
+			IPSet: IPSet{ID: "ips-123", Name: "office", CIDRs: req.CIDRs},
+		})
+	})
+	defer server.Close()
+
+	c := newTestClient(server)
+	description := ""
+	ipSet, err := c.UpdateIPSet(context.Background(), "ips-123", UpdateIPSetRequest{
+		Description: &description,
+		CIDRs:       []string{"192.0.2.0/24"},
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if ipSet.CIDRs[0] != "192.0.2.0/24" {
+		t.Errorf("CIDRs[0] = %v, want 192.0.2.0/24", ipSet.CIDRs[0])
+	}
+}
+
+func TestClient_DeployFirewallsUsingIPSet(t *testing.T) {
+	var deployed []string
+	server := newTestServer(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/firewalls":
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(listFirewallsResponse{
+				Data: []Firewall{
+					{ID: "fw-1", Rules: []FirewallRule{{ID: "r-1", SourceIPSetIDs: []string{"ips-123"}}}},
+					{ID: "fw-2", Rules: []FirewallRule{{ID: "r-2", SourceIPs: []string{"0.0.0.0/0"}}}},
+					{ID: "fw-3", Rules: []FirewallRule{{ID: "r-3", SourceIPSetIDs: []string{"ips-999", "ips-123"}}}},
+				},
+				Pagination: Pagination{CurrentPage: 1, LastPage: 1},
+			})
+		case r.Method == "POST":
+			deployed = append(deployed, r.URL.Path)
+			w.WriteHeader(http.StatusOK)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+	defer server.Close()
+
+	c := newTestClient(server)
+	ids, err := c.DeployFirewallsUsingIPSet(context.Background(), "ips-123")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(ids) != 2 || ids[0] != "fw-1" || ids[1] != "fw-3" {
+		t.Errorf("deployed IDs = %v, want [fw-1 fw-3]", ids)
+	}
+	if len(deployed) != 2 || deployed[0] != "/firewalls/fw-1/deploy" || deployed[1] != "/firewalls/fw-3/deploy" {
+		t.Errorf("deploy calls = %v, want fw-1 and fw-3", deployed)
+	}
+}
+
+func TestClient_DeleteIPSet(t *testing.T) {
+	server := newTestServer(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "DELETE" {
+			t.Errorf("Method = %v, want DELETE", r.Method)
+		}
+		if r.URL.Path != "/ip-sets/ips-123" {
+			t.Errorf("Path = %v, want /ip-sets/ips-123", r.URL.Path)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+	defer server.Close()
+
+	c := newTestClient(server)
+	if err := c.DeleteIPSet(context.Background(), "ips-123"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
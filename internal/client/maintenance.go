@@ -0,0 +1,19 @@
+package client
+
+// MaintenanceWindow is the weekly window in which a database or cache
+// instance applies queued disruptive changes.
+type MaintenanceWindow struct {
+	DayOfWeek     string `json:"day_of_week"` // monday ... sunday
+	StartHour     int    `json:"start_hour"`  // UTC, 0-23
+	DurationHours int    `json:"duration_hours"`
+}
+
+// PendingChanges are disruptive changes queued for the next maintenance
+// window. Nil fields are not changing.
+type PendingChanges struct {
+	ResourceProfile  *string `json:"resource_profile"`
+	ParameterGroupID *string `json:"parameter_group_id"`
+	// ScheduledAt is the start of the maintenance window the changes are
+	// applied in.
+	ScheduledAt string `json:"scheduled_at"`
+}
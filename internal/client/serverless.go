@@ -8,26 +8,40 @@ import (
 
 // ServerlessContainer represents a serverless container from the API
 type ServerlessContainer struct {
-	ID                   string            `json:"id"`
-	TeamID               int               `json:"team_id"`
-	UserID               int               `json:"user_id"`
-	Name                 string            `json:"name"`
-	Status               string            `json:"status"`
-	ResourceProfile      string            `json:"resource_profile"`
-	DeploymentType       string            `json:"deployment_type"`
-	SourceType           *string           `json:"source_type"`
-	Image                *string           `json:"image"`
-	ImageTag             string            `json:"image_tag"`
-	RepositoryURL        *string           `json:"repository_url"`
-	RepositoryBranch     string            `json:"repository_branch"`
-	GitAuthType          string            `json:"git_auth_type"`
-	Port                 int               `json:"port"`
-	MinScale             int               `json:"min_scale"`
-	MaxScale             int               `json:"max_scale"`
-	EnvironmentVariables map[string]string `json:"environment_variables"`
-	URL                  string            `json:"url"`
-	CreatedAt            string            `json:"created_at"`
-	UpdatedAt            string            `json:"updated_at"`
+	ID                   string                 `json:"id"`
+	TeamID               int                    `json:"team_id"`
+	UserID               int                    `json:"user_id"`
+	Name                 string                 `json:"name"`
+	Status               string                 `json:"status"`
+	ResourceProfile      string                 `json:"resource_profile"`
+	DeploymentType       string                 `json:"deployment_type"`
+	SourceType           *string                `json:"source_type"`
+	Image                *string                `json:"image"`
+	ImageTag             string                 `json:"image_tag"`
+	ImageDigest          *string                `json:"image_digest"`
+	RepositoryURL        *string                `json:"repository_url"`
+	RepositoryBranch     string                 `json:"repository_branch"`
+	GitAuthType          string                 `json:"git_auth_type"`
+	Port                 int                    `json:"port"`
+	MinScale             int                    `json:"min_scale"`
+	MaxScale             int                    `json:"max_scale"`
+	HealthCheck          *ServerlessHealthCheck `json:"health_check"`
+	Autoscaling          *ServerlessAutoscaling `json:"autoscaling"`
+	EnvironmentVariables map[string]string      `json:"environment_variables"`
+	SourceSHA256         *string                `json:"source_sha256"`
+
+	// Revision is the number of the latest revision. Traffic lists how requests
+	// are split between revisions; PinnedRevision, when set, receives all of it.
+	Revision       int                       `json:"revision"`
+	Traffic        []ServerlessTrafficTarget `json:"traffic"`
+	PinnedRevision *int                      `json:"pinned_revision"`
+
+	// SecretEnvironmentVariableHashes maps each secret name to the hex SHA-256
+	// of its value. The values themselves are never returned.
+	SecretEnvironmentVariableHashes map[string]string `json:"secret_environment_variable_hashes"`
+	URL                             string            `json:"url"`
+	CreatedAt                       string            `json:"created_at"`
+	UpdatedAt                       string            `json:"updated_at"`
 
 	// MonthlyCost is not a container column. It is the sibling `monthly_cost`
 	// field (current_month_cost_cents / 100) the show endpoint returns
@@ -35,22 +49,46 @@ type ServerlessContainer struct {
 	MonthlyCost float64 `json:"-"`
 }
 
+// ServerlessHealthCheck configures the HTTP probe used for readiness and
+// liveness. Without one, an instance is ready as soon as its port accepts
+// connections.
+type ServerlessHealthCheck struct {
+	Path                  string `json:"path"`
+	IntervalSeconds       int    `json:"interval_seconds"`
+	TimeoutSeconds        int    `json:"timeout_seconds"`
+	FailureThreshold      int    `json:"failure_threshold"`
+	StartupTimeoutSeconds int    `json:"startup_timeout_seconds"`
+}
+
+// ServerlessAutoscaling configures concurrency-based scaling between
+// min_scale and max_scale. Without it, the platform defaults apply.
+type ServerlessAutoscaling struct {
+	TargetConcurrency     int `json:"target_concurrency"`
+	ScaleDownDelaySeconds int `json:"scale_down_delay_seconds"`
+	RequestTimeoutSeconds int `json:"request_timeout_seconds"`
+}
+
 // CreateServerlessRequest represents a request to create a serverless container
 type CreateServerlessRequest struct {
-	Name                 string            `json:"name"`
-	DeploymentType       string            `json:"deployment_type"`
-	ResourceProfile      string            `json:"resource_profile,omitempty"`
-	Image                string            `json:"image,omitempty"`
-	ImageTag             string            `json:"image_tag,omitempty"`
-	RepositoryURL        string            `json:"repository_url,omitempty"`
-	RepositoryBranch     string            `json:"repository_branch,omitempty"`
-	SourceType           string            `json:"source_type,omitempty"`
-	GitAuthType          string            `json:"git_auth_type,omitempty"`
-	GitCredentials       string            `json:"git_credentials,omitempty"`
-	Port                 int               `json:"port,omitempty"`
-	MinScale             int               `json:"min_scale,omitempty"`
-	MaxScale             int               `json:"max_scale,omitempty"`
-	EnvironmentVariables map[string]string `json:"environment_variables,omitempty"`
+	Name                 string                 `json:"name"`
+	DeploymentType       string                 `json:"deployment_type"`
+	ResourceProfile      string                 `json:"resource_profile,omitempty"`
+	Image                string                 `json:"image,omitempty"`
+	ImageTag             string                 `json:"image_tag,omitempty"`
+	ImageDigest          string                 `json:"image_digest,omitempty"`
+	RepositoryURL        string                 `json:"repository_url,omitempty"`
+	RepositoryBranch     string                 `json:"repository_branch,omitempty"`
+	SourceType           string                 `json:"source_type,omitempty"`
+	GitAuthType          string                 `json:"git_auth_type,omitempty"`
+	GitCredentials       string                 `json:"git_credentials,omitempty"`
+	Port                 int                    `json:"port,omitempty"`
+	MinScale             int                    `json:"min_scale,omitempty"`
+	MaxScale             int                    `json:"max_scale,omitempty"`
+	HealthCheck          *ServerlessHealthCheck `json:"health_check,omitempty"`
+	Autoscaling          *ServerlessAutoscaling `json:"autoscaling,omitempty"`
+	EnvironmentVariables map[string]string      `json:"environment_variables,omitempty"`
+
+	SecretEnvironmentVariables map[string]string `json:"secret_environment_variables,omitempty"`
 }
 
 // UpdateServerlessRequest represents a request to update a serverless container
@@ -58,6 +96,7 @@ type UpdateServerlessRequest struct {
 	ResourceProfile      string            `json:"resource_profile,omitempty"`
 	Image                string            `json:"image,omitempty"`
 	ImageTag             string            `json:"image_tag,omitempty"`
+	ImageDigest          *string           `json:"image_digest,omitempty"` // "" unpins the digest
 	RepositoryURL        string            `json:"repository_url,omitempty"`
 	RepositoryBranch     string            `json:"repository_branch,omitempty"`
 	SourceType           string            `json:"source_type,omitempty"`
@@ -67,6 +106,11 @@ type UpdateServerlessRequest struct {
 	MinScale             *int              `json:"min_scale,omitempty"`
 	MaxScale             *int              `json:"max_scale,omitempty"`
 	EnvironmentVariables map[string]string `json:"environment_variables,omitempty"`
+
+	// HealthCheck and Autoscaling are always sent: null removes the health
+	// check and restores the platform's autoscaling defaults.
+	HealthCheck *ServerlessHealthCheck `json:"health_check"`
+	Autoscaling *ServerlessAutoscaling `json:"autoscaling"`
 }
 
 type createServerlessResponse struct {
@@ -135,6 +179,20 @@ func (c *Client) UpdateServerless(ctx context.Context, id string, req UpdateServ
 	return &resp.Container, nil
 }
 
+type setServerlessSecretsRequest struct {
+	Secrets map[string]string `json:"secrets"`
+}
+
+// SetServerlessSecrets replaces the full set of secret environment variables of
+// a serverless container. Names missing from secrets are removed; an empty map
+// clears them all. The container is redeployed to pick up the change.
+func (c *Client) SetServerlessSecrets(ctx context.Context, id string, secrets map[string]string) error {
+	if secrets == nil {
+		secrets = map[string]string{}
+	}
+	return c.doRequest(ctx, "PUT", fmt.Sprintf("/serverless/%s/secrets", id), setServerlessSecretsRequest{Secrets: secrets}, nil)
+}
+
 // DeleteServerless deletes a serverless container
 func (c *Client) DeleteServerless(ctx context.Context, id string) error {
 	return c.doRequest(ctx, "DELETE", fmt.Sprintf("/serverless/%s", id), nil, nil)
@@ -189,7 +247,7 @@ func (c *Client) WaitForServerlessStatus(ctx context.Context, id string, targetS
 			}
 
 			if status == "error" || status == "failed" {
-				return fmt.Errorf("serverless container %s entered error state", id)
+				return c.failedServerlessError(ctx, id, status)
 			}
 		}
 	}
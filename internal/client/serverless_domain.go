@@ -0,0 +1,95 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// ServerlessDomain represents a custom domain attached to a serverless
+// container. The DNS instructions have the same shape as for static sites.
+type ServerlessDomain struct {
+	ID                 string                          `json:"id"`
+	Domain             string                          `json:"domain"`
+	VerificationStatus string                          `json:"verification_status"` // pending, verifying, verified, failed
+	TLSStatus          string                          `json:"tls_status"`          // pending, provisioning, active, failed
+	DNSInstructions    StaticSiteDomainDNSInstructions `json:"dns_instructions"`
+	CreatedAt          string                          `json:"created_at"`
+}
+
+// AddServerlessDomainRequest is the payload for adding a custom domain.
+type AddServerlessDomainRequest struct {
+	Domain string `json:"domain"`
+}
+
+type serverlessDomainResponse struct {
+	Message string           `json:"message"`
+	Data    ServerlessDomain `json:"data"`
+}
+
+type listServerlessDomainsResponse struct {
+	Data []ServerlessDomain `json:"data"`
+}
+
+// ListServerlessDomains lists all custom domains of a serverless container.
+func (c *Client) ListServerlessDomains(ctx context.Context, containerID string) ([]ServerlessDomain, error) {
+	var resp listServerlessDomainsResponse
+	if err := c.doRequest(ctx, "GET", fmt.Sprintf("/serverless/%s/domains", containerID), nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Data, nil
+}
+
+// AddServerlessDomain adds a custom domain to a serverless container.
+func (c *Client) AddServerlessDomain(ctx context.Context, containerID string, req AddServerlessDomainRequest) (*ServerlessDomain, error) {
+	var resp serverlessDomainResponse
+	if err := c.doRequest(ctx, "POST", fmt.Sprintf("/serverless/%s/domains", containerID), req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.Data, nil
+}
+
+// DeleteServerlessDomain removes a custom domain from a serverless container.
+func (c *Client) DeleteServerlessDomain(ctx context.Context, containerID, domainID string) error {
+	return c.doRequest(ctx, "DELETE", fmt.Sprintf("/serverless/%s/domains/%s", containerID, domainID), nil, nil)
+}
+
+// VerifyServerlessDomain triggers verification of a custom domain.
+func (c *Client) VerifyServerlessDomain(ctx context.Context, containerID, domainID string) error {
+	return c.doRequest(ctx, "POST", fmt.Sprintf("/serverless/%s/domains/%s/verify", containerID, domainID), nil, nil)
+}
+
+// FindServerlessDomain looks up a domain on a serverless container by name.
+func (c *Client) FindServerlessDomain(ctx context.Context, containerID, domain string) (*ServerlessDomain, error) {
+	domains, err := c.ListServerlessDomains(ctx, containerID)
+	if err != nil {
+		return nil, err
+	}
+	for i := range domains {
+		if domains[i].Domain == domain {
+			return &domains[i], nil
+		}
+	}
+	return nil, &NotFoundError{Resource: "serverless domain", ID: domain}
+}
+
+// WaitForServerlessDomain waits for a custom domain to be verified and, when
+// waitForTLS is set, for its TLS certificate to be issued. It returns the
+// domain as last seen, also on error.
+func (c *Client) WaitForServerlessDomain(ctx context.Context, containerID, domain string, waitForTLS bool, timeout time.Duration) (*ServerlessDomain, error) {
+	var last *ServerlessDomain
+	find := func(ctx context.Context) (customDomainState, error) {
+		d, err := c.FindServerlessDomain(ctx, containerID, domain)
+		if err != nil {
+			return customDomainState{}, err
+		}
+		last = d
+		return customDomainState{ID: d.ID, VerificationStatus: d.VerificationStatus, TLSStatus: d.TLSStatus, DNSInstructions: d.DNSInstructions}, nil
+	}
+	verify := func(ctx context.Context, id string) error {
+		return c.VerifyServerlessDomain(ctx, containerID, id)
+	}
+
+	err := waitForCustomDomain(ctx, domain, waitForTLS, timeout, find, verify)
+	return last, err
+}
@@ -0,0 +1,196 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestClient_AddServerlessDomain(t *testing.T) {
+	server := newTestServer(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" {
+			t.Errorf("Method = %v, want POST", r.Method)
+		}
+		if r.URL.Path != "/serverless/srv-123/domains" {
+			t.Errorf("Path = %v, want /serverless/srv-123/domains", r.URL.Path)
+		}
+
+		var req AddServerlessDomainRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+		if req.Domain != "api.example.com" {
+			t.Errorf("Domain = %v, want api.example.com", req.Domain)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(serverlessDomainResponse{
+			Message: "Domain added",
+			Data: ServerlessDomain{
+				ID:                 "domain-7",
+				Domain:             "api.example.com",
+				VerificationStatus: "pending",
+				TLSStatus:          "pending",
+				DNSInstructions: StaticSiteDomainDNSInstructions{
+					RecordType:  "CNAME",
+					RecordName:  "api.example.com",
+					RecordValue: "my-app.serverless.danubedata.ro",
+				},
+			},
+		})
+	})
+	defer server.Close()
+
+	c := newTestClient(server)
+	d, err := c.AddServerlessDomain(context.Background(), "srv-123", AddServerlessDomainRequest{Domain: "api.example.com"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if d.ID != "domain-7" {
+		t.Errorf("ID = %v, want domain-7", d.ID)
+	}
+	if d.DNSInstructions.RecordType != "CNAME" {
+		t.Errorf("DNSInstructions.RecordType = %v, want CNAME", d.DNSInstructions.RecordType)
+	}
+}
+
+func TestClient_FindServerlessDomain(t *testing.T) {
+	server := newTestServer(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/serverless/srv-123/domains" {
+			t.Errorf("Path = %v, want /serverless/srv-123/domains", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(listServerlessDomainsResponse{
+			Data: []ServerlessDomain{
+				{ID: "domain-1", Domain: "www.example.com", VerificationStatus: "verified"},
+				{ID: "domain-2", Domain: "api.example.com", VerificationStatus: "pending"},
+			},
+		})
+	})
+	defer server.Close()
+
+	c := newTestClient(server)
+	d, err := c.FindServerlessDomain(context.Background(), "srv-123", "api.example.com")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if d.ID != "domain-2" {
+		t.Errorf("ID = %v, want domain-2", d.ID)
+	}
+
+	_, err = c.FindServerlessDomain(context.Background(), "srv-123", "missing.example.com")
+	if !IsNotFound(err) {
+		t.Errorf("expected a not found error, got %v", err)
+	}
+}
+
+func TestClient_DeleteServerlessDomain(t *testing.T) {
+	server := newTestServer(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "DELETE" {
+			t.Errorf("Method = %v, want DELETE", r.Method)
+		}
+		if r.URL.Path != "/serverless/srv-123/domains/domain-7" {
+			t.Errorf("Path = %v, want /serverless/srv-123/domains/domain-7", r.URL.Path)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+	defer server.Close()
+
+	c := newTestClient(server)
+	if err := c.DeleteServerlessDomain(context.Background(), "srv-123", "domain-7"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestClient_WaitForServerlessDomain(t *testing.T) {
+	tests := []struct {
+		name       string
+		domain     ServerlessDomain
+		waitForTLS bool
+		wantErr    string
+	}{
+		{
+			name:   "verified",
+			domain: ServerlessDomain{ID: "domain-7", Domain: "api.example.com", VerificationStatus: "verified", TLSStatus: "provisioning"},
+		},
+		{
+			name:       "tls active",
+			domain:     ServerlessDomain{ID: "domain-7", Domain: "api.example.com", VerificationStatus: "verified", TLSStatus: "active"},
+			waitForTLS: true,
+		},
+		{
+			name:       "tls failed",
+			domain:     ServerlessDomain{ID: "domain-7", Domain: "api.example.com", VerificationStatus: "verified", TLSStatus: "failed"},
+			waitForTLS: true,
+			wantErr:    "TLS certificate issuance",
+		},
+		{
+			name: "verification failed",
+			domain: ServerlessDomain{ID: "domain-7", Domain: "api.example.com", VerificationStatus: "failed",
+				DNSInstructions: StaticSiteDomainDNSInstructions{RecordType: "CNAME", RecordName: "api.example.com", RecordValue: "my-app.serverless.danubedata.ro"}},
+			wantErr: `CNAME record api.example.com has the value "my-app.serverless.danubedata.ro"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newTestServer(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != "GET" {
+					t.Errorf("unexpected %s %s", r.Method, r.URL.Path)
+				}
+				w.Header().Set("Content-Type", "application/json")
+				_ = json.NewEncoder(w).Encode(listServerlessDomainsResponse{Data: []ServerlessDomain{tt.domain}})
+			})
+			defer server.Close()
+
+			c := newTestClient(server)
+			_, err := c.WaitForServerlessDomain(context.Background(), "srv-123", "api.example.com", tt.waitForTLS, time.Minute)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("error = %v, want it to contain %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestClient_WaitForServerlessDomain_TriggersVerification(t *testing.T) {
+	verified := false
+	server := newTestServer(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "POST" {
+			if r.URL.Path != "/serverless/srv-123/domains/domain-7/verify" {
+				t.Errorf("Path = %v, want /serverless/srv-123/domains/domain-7/verify", r.URL.Path)
+			}
+			verified = true
+			w.WriteHeader(http.StatusAccepted)
+			return
+		}
+
+		status := "pending"
+		if verified {
+			status = "verified"
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(listServerlessDomainsResponse{
+			Data: []ServerlessDomain{{ID: "domain-7", Domain: "api.example.com", VerificationStatus: status}},
+		})
+	})
+	defer server.Close()
+
+	c := newTestClient(server)
+	d, err := c.WaitForServerlessDomain(context.Background(), "srv-123", "api.example.com", false, time.Minute)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if d.VerificationStatus != "verified" {
+		t.Errorf("VerificationStatus = %v, want verified", d.VerificationStatus)
+	}
+}
@@ -0,0 +1,98 @@
+package client
+
+import (
+	"context"
+	"fmt"
+)
+
+// ServerlessJob is a container run on a cron schedule. It runs either a
+// container image, or a revision of an existing serverless container.
+type ServerlessJob struct {
+	ID                   string            `json:"id"`
+	Name                 string            `json:"name"`
+	Schedule             string            `json:"schedule"`
+	Timezone             string            `json:"timezone"`
+	Image                *string           `json:"image"`
+	ImageTag             string            `json:"image_tag"`
+	ContainerID          *string           `json:"container_id"`
+	Revision             *int              `json:"revision"` // nil runs the container's latest revision
+	ResourceProfile      string            `json:"resource_profile"`
+	Command              []string          `json:"command"`
+	EnvironmentVariables map[string]string `json:"environment_variables"`
+	TimeoutSeconds       int               `json:"timeout_seconds"`
+	MaxRetries           int               `json:"max_retries"`
+	RetryDelaySeconds    int               `json:"retry_delay_seconds"`
+	Suspended            bool              `json:"suspended"`
+	LastRun              *ServerlessJobRun `json:"last_run"`
+	NextRunAt            *string           `json:"next_run_at"`
+	CreatedAt            string            `json:"created_at"`
+	UpdatedAt            string            `json:"updated_at"`
+}
+
+// ServerlessJobRun is a single scheduled execution of a job, including its
+// retries.
+type ServerlessJobRun struct {
+	ID         string  `json:"id"`
+	Status     string  `json:"status"` // running, succeeded, failed, timed_out
+	Attempts   int     `json:"attempts"`
+	ExitCode   *int    `json:"exit_code"`
+	StartedAt  string  `json:"started_at"`
+	FinishedAt *string `json:"finished_at"`
+}
+
+// ServerlessJobRequest creates or updates a serverless job. Updates replace
+// every field, so it is always sent in full.
+type ServerlessJobRequest struct {
+	Name                 string            `json:"name"`
+	Schedule             string            `json:"schedule"`
+	Timezone             string            `json:"timezone"`
+	Image                *string           `json:"image"`
+	ImageTag             string            `json:"image_tag,omitempty"`
+	ContainerID          *string           `json:"container_id"`
+	Revision             *int              `json:"revision"`
+	ResourceProfile      string            `json:"resource_profile,omitempty"`
+	Command              []string          `json:"command"`
+	EnvironmentVariables map[string]string `json:"environment_variables"`
+	TimeoutSeconds       int               `json:"timeout_seconds"`
+	MaxRetries           int               `json:"max_retries"`
+	RetryDelaySeconds    int               `json:"retry_delay_seconds"`
+	Suspended            bool              `json:"suspended"`
+}
+
+type serverlessJobResponse struct {
+	Message string        `json:"message"`
+	Data    ServerlessJob `json:"data"`
+}
+
+// CreateServerlessJob creates a new scheduled job.
+func (c *Client) CreateServerlessJob(ctx context.Context, req ServerlessJobRequest) (*ServerlessJob, error) {
+	var resp serverlessJobResponse
+	if err := c.doRequest(ctx, "POST", "/serverless-jobs", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.Data, nil
+}
+
+// GetServerlessJob retrieves a scheduled job by ID, including its last run.
+func (c *Client) GetServerlessJob(ctx context.Context, id string) (*ServerlessJob, error) {
+	var resp serverlessJobResponse
+	if err := c.doRequest(ctx, "GET", fmt.Sprintf("/serverless-jobs/%s", id), nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.Data, nil
+}
+
+// UpdateServerlessJob replaces the configuration of a scheduled job. Runs in
+// progress finish with their old configuration.
+func (c *Client) UpdateServerlessJob(ctx context.Context, id string, req ServerlessJobRequest) (*ServerlessJob, error) {
+	var resp serverlessJobResponse
+	if err := c.doRequest(ctx, "PUT", fmt.Sprintf("/serverless-jobs/%s", id), req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.Data, nil
+}
+
+// DeleteServerlessJob deletes a scheduled job and cancels any run in progress.
+func (c *Client) DeleteServerlessJob(ctx context.Context, id string) error {
+	return c.doRequest(ctx, "DELETE", fmt.Sprintf("/serverless-jobs/%s", id), nil, nil)
+}
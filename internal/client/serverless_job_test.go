@@ -0,0 +1,116 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestClient_CreateServerlessJob(t *testing.T) {
+	server := newTestServer(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" {
+			t.Errorf("Method = %v, want POST", r.Method)
+		}
+		if r.URL.Path != "/serverless-jobs" {
+			t.Errorf("Path = %v, want /serverless-jobs", r.URL.Path)
+		}
+
+		var body map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+		if body["schedule"] != "0 3 * * *" {
+			t.Errorf("schedule = %v, want 0 3 * * *", body["schedule"])
+		}
+		// The job runs a container, so image is sent as an explicit null.
+		if image, ok := body["image"]; !ok || image != nil {
+			t.Errorf("image = %v (present %v), want null", image, ok)
+		}
+		if body["container_id"] != "srv-123" {
+			t.Errorf("container_id = %v, want srv-123", body["container_id"])
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(serverlessJobResponse{
+			Message: "Job created",
+			Data: ServerlessJob{
+				ID:             "job-1",
+				Name:           "nightly-report",
+				Schedule:       "0 3 * * *",
+				Timezone:       "UTC",
+				ContainerID:    strPtr("srv-123"),
+				Command:        []string{"bin/report", "--daily"},
+				TimeoutSeconds: 1800,
+				MaxRetries:     2,
+			},
+		})
+	})
+	defer server.Close()
+
+	c := newTestClient(server)
+	containerID := "srv-123"
+	job, err := c.CreateServerlessJob(context.Background(), ServerlessJobRequest{
+		Name:           "nightly-report",
+		Schedule:       "0 3 * * *",
+		Timezone:       "UTC",
+		ContainerID:    &containerID,
+		Command:        []string{"bin/report", "--daily"},
+		TimeoutSeconds: 1800,
+		MaxRetries:     2,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if job.ID != "job-1" {
+		t.Errorf("ID = %v, want job-1", job.ID)
+	}
+	if len(job.Command) != 2 {
+		t.Errorf("Command = %v, want 2 elements", job.Command)
+	}
+}
+
+func TestClient_GetServerlessJob_LastRun(t *testing.T) {
+	server := newTestServer(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/serverless-jobs/job-1" {
+			t.Errorf("Path = %v, want /serverless-jobs/job-1", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":{"id":"job-1","schedule":"0 3 * * *","last_run":{"id":"run-9","status":"failed","attempts":3,"exit_code":1,"started_at":"2026-10-17T03:00:00Z","finished_at":"2026-10-17T03:04:10Z"},"next_run_at":"2026-10-18T03:00:00Z"}}`))
+	})
+	defer server.Close()
+
+	c := newTestClient(server)
+	job, err := c.GetServerlessJob(context.Background(), "job-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if job.LastRun == nil {
+		t.Fatal("LastRun = nil, want a run")
+	}
+	if job.LastRun.Status != "failed" || job.LastRun.Attempts != 3 {
+		t.Errorf("LastRun = %+v, want failed after 3 attempts", job.LastRun)
+	}
+	if job.LastRun.ExitCode == nil || *job.LastRun.ExitCode != 1 {
+		t.Errorf("ExitCode = %v, want 1", job.LastRun.ExitCode)
+	}
+}
+
+func TestClient_DeleteServerlessJob(t *testing.T) {
+	server := newTestServer(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "DELETE" {
+			t.Errorf("Method = %v, want DELETE", r.Method)
+		}
+		if r.URL.Path != "/serverless-jobs/job-1" {
+			t.Errorf("Path = %v, want /serverless-jobs/job-1", r.URL.Path)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+	defer server.Close()
+
+	c := newTestClient(server)
+	if err := c.DeleteServerlessJob(context.Background(), "job-1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
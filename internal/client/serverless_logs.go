@@ -0,0 +1,111 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// serverlessFailureLogLines is how many lines of each log stream are attached
+// to the error returned when a container or build fails.
+const serverlessFailureLogLines = 50
+
+// ServerlessLogLine is a single line of a serverless container's build or
+// runtime log.
+type ServerlessLogLine struct {
+	Timestamp string `json:"timestamp"`
+	Stream    string `json:"stream"` // build or runtime
+	Message   string `json:"message"`
+}
+
+// ServerlessLogsQuery selects log lines. Stream is "build" or "runtime"; Since
+// is an RFC 3339 timestamp; BuildID restricts build logs to a single build.
+// Zero values are omitted and the API defaults apply.
+type ServerlessLogsQuery struct {
+	Stream  string
+	Since   string
+	Limit   int
+	BuildID string
+}
+
+type serverlessLogsResponse struct {
+	Data []ServerlessLogLine `json:"data"`
+}
+
+// GetServerlessLogs retrieves the most recent log lines of a serverless
+// container, oldest first.
+func (c *Client) GetServerlessLogs(ctx context.Context, id string, query ServerlessLogsQuery) ([]ServerlessLogLine, error) {
+	params := url.Values{}
+	if query.Stream != "" {
+		params.Set("stream", query.Stream)
+	}
+	if query.Since != "" {
+		params.Set("since", query.Since)
+	}
+	if query.Limit > 0 {
+		params.Set("limit", strconv.Itoa(query.Limit))
+	}
+	if query.BuildID != "" {
+		params.Set("build_id", query.BuildID)
+	}
+
+	path := fmt.Sprintf("/serverless/%s/logs", id)
+	if len(params) > 0 {
+		path += "?" + params.Encode()
+	}
+
+	var resp serverlessLogsResponse
+	if err := c.doRequest(ctx, "GET", path, nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Data, nil
+}
+
+// ServerlessFailedError is returned when a serverless container enters an error
+// state. It carries the tail of the build and runtime logs, when available.
+type ServerlessFailedError struct {
+	ContainerID string
+	Status      string
+	BuildLog    []ServerlessLogLine
+	RuntimeLog  []ServerlessLogLine
+
+	// LogErr is set when the logs could not be fetched.
+	LogErr error
+}
+
+func (e *ServerlessFailedError) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "serverless container %s entered %s state", e.ContainerID, e.Status)
+	writeServerlessLog(&b, "build", e.BuildLog)
+	writeServerlessLog(&b, "runtime", e.RuntimeLog)
+	if e.LogErr != nil {
+		fmt.Fprintf(&b, "\n\n(logs unavailable: %s)", e.LogErr)
+	}
+	return b.String()
+}
+
+// failedServerlessError builds a ServerlessFailedError, fetching the tail of
+// both log streams on a best-effort basis.
+func (c *Client) failedServerlessError(ctx context.Context, id, status string) *ServerlessFailedError {
+	failed := &ServerlessFailedError{ContainerID: id, Status: status}
+
+	failed.BuildLog, failed.LogErr = c.GetServerlessLogs(ctx, id, ServerlessLogsQuery{Stream: "build", Limit: serverlessFailureLogLines})
+	if failed.LogErr != nil {
+		return failed
+	}
+	failed.RuntimeLog, failed.LogErr = c.GetServerlessLogs(ctx, id, ServerlessLogsQuery{Stream: "runtime", Limit: serverlessFailureLogLines})
+	return failed
+}
+
+// writeServerlessLog appends a titled log excerpt to b. Empty logs are skipped.
+func writeServerlessLog(b *strings.Builder, stream string, lines []ServerlessLogLine) {
+	if len(lines) == 0 {
+		return
+	}
+	fmt.Fprintf(b, "\n\nLast %d lines of the %s log:", len(lines), stream)
+	for _, line := range lines {
+		fmt.Fprintf(b, "\n%s %s", line.Timestamp, line.Message)
+	}
+}
@@ -0,0 +1,113 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestClient_GetServerlessLogs(t *testing.T) {
+	server := newTestServer(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/serverless/srv-123/logs" {
+			t.Errorf("Path = %v, want /serverless/srv-123/logs", r.URL.Path)
+		}
+		q := r.URL.Query()
+		if q.Get("stream") != "build" {
+			t.Errorf("stream = %v, want build", q.Get("stream"))
+		}
+		if q.Get("since") != "2026-10-01T00:00:00Z" {
+			t.Errorf("since = %v, want 2026-10-01T00:00:00Z", q.Get("since"))
+		}
+		if q.Get("limit") != "20" {
+			t.Errorf("limit = %v, want 20", q.Get("limit"))
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(serverlessLogsResponse{
+			Data: []ServerlessLogLine{
+				{Timestamp: "2026-10-01T10:00:00Z", Stream: "build", Message: "Step 1/4 : FROM node:22"},
+				{Timestamp: "2026-10-01T10:00:05Z", Stream: "build", Message: "npm ERR! missing script: build"},
+			},
+		})
+	})
+	defer server.Close()
+
+	c := newTestClient(server)
+	lines, err := c.GetServerlessLogs(context.Background(), "srv-123", ServerlessLogsQuery{
+		Stream: "build",
+		Since:  "2026-10-01T00:00:00Z",
+		Limit:  20,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(lines) != 2 {
+		t.Fatalf("len(lines) = %d, want 2", len(lines))
+	}
+	if lines[1].Message != "npm ERR! missing script: build" {
+		t.Errorf("lines[1].Message = %v", lines[1].Message)
+	}
+}
+
+func TestClient_GetServerlessLogs_NoQuery(t *testing.T) {
+	server := newTestServer(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.RawQuery != "" {
+			t.Errorf("RawQuery = %v, want empty", r.URL.RawQuery)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data": []}`))
+	})
+	defer server.Close()
+
+	c := newTestClient(server)
+	if _, err := c.GetServerlessLogs(context.Background(), "srv-123", ServerlessLogsQuery{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestClient_FailedServerlessError_AttachesLogs(t *testing.T) {
+	server := newTestServer(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("limit") != "50" {
+			t.Errorf("limit = %v, want 50", r.URL.Query().Get("limit"))
+		}
+		stream := r.URL.Query().Get("stream")
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(serverlessLogsResponse{
+			Data: []ServerlessLogLine{{Timestamp: "2026-10-01T10:00:00Z", Stream: stream, Message: stream + " failure"}},
+		})
+	})
+	defer server.Close()
+
+	c := newTestClient(server)
+	var err error = c.failedServerlessError(context.Background(), "srv-123", "failed")
+
+	var failed *ServerlessFailedError
+	if !errors.As(err, &failed) {
+		t.Fatalf("error = %T, want *ServerlessFailedError", err)
+	}
+	msg := err.Error()
+	for _, want := range []string{"srv-123 entered failed state", "build log:", "build failure", "runtime log:", "runtime failure"} {
+		if !strings.Contains(msg, want) {
+			t.Errorf("error %q does not contain %q", msg, want)
+		}
+	}
+}
+
+func TestClient_FailedServerlessError_LogsUnavailable(t *testing.T) {
+	server := newTestServer(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+	defer server.Close()
+
+	c := newTestClient(server)
+	err := c.failedServerlessError(context.Background(), "srv-123", "error")
+	if err.LogErr == nil {
+		t.Fatal("LogErr = nil, want the log fetch error")
+	}
+	if !strings.Contains(err.Error(), "logs unavailable") {
+		t.Errorf("error %q should mention unavailable logs", err.Error())
+	}
+}
@@ -0,0 +1,75 @@
+package client
+
+import (
+	"context"
+	"fmt"
+)
+
+// ServerlessRevision is an immutable snapshot of a serverless container's
+// deployment. Every update that changes what runs creates a new revision.
+type ServerlessRevision struct {
+	ID              string  `json:"id"`
+	Number          int     `json:"number"`
+	Image           *string `json:"image"`
+	ImageTag        string  `json:"image_tag"`
+	SourceSHA256    *string `json:"source_sha256"`
+	EnvironmentHash string  `json:"environment_hash"`
+	Status          string  `json:"status"` // deploying, ready, failed, retired
+	TrafficPercent  int     `json:"traffic_percent"`
+	CreatedAt       string  `json:"created_at"`
+}
+
+// ServerlessTrafficTarget routes a share of requests to a revision. Exactly one
+// of Revision and LatestRevision is set.
+type ServerlessTrafficTarget struct {
+	Revision       *int `json:"revision,omitempty"`
+	LatestRevision bool `json:"latest_revision,omitempty"`
+	Percent        int  `json:"percent"`
+}
+
+// SetServerlessTrafficRequest replaces the traffic routing of a container.
+// PinnedRevision sends all traffic to one revision and takes precedence over
+// Traffic. Leaving both empty routes all traffic to the latest revision.
+type SetServerlessTrafficRequest struct {
+	PinnedRevision *int                      `json:"pinned_revision"`
+	Traffic        []ServerlessTrafficTarget `json:"traffic"`
+}
+
+type listServerlessRevisionsResponse struct {
+	Data       []ServerlessRevision `json:"data"`
+	Pagination Pagination           `json:"pagination"`
+}
+
+// ListServerlessRevisions retrieves all revisions of a serverless container,
+// newest first (handles pagination automatically)
+func (c *Client) ListServerlessRevisions(ctx context.Context, id string) ([]ServerlessRevision, error) {
+	var allRevisions []ServerlessRevision
+	page := 1
+
+	for {
+		var resp listServerlessRevisionsResponse
+		if err := c.doRequest(ctx, "GET", fmt.Sprintf("/serverless/%s/revisions?page=%d", id, page), nil, &resp); err != nil {
+			return nil, err
+		}
+		allRevisions = append(allRevisions, resp.Data...)
+
+		if page >= resp.Pagination.LastPage || len(resp.Data) == 0 {
+			break
+		}
+		page++
+	}
+	return allRevisions, nil
+}
+
+// SetServerlessTraffic replaces how requests are split between the revisions of
+// a serverless container. Switching traffic never rebuilds a revision.
+func (c *Client) SetServerlessTraffic(ctx context.Context, id string, req SetServerlessTrafficRequest) (*ServerlessContainer, error) {
+	if req.Traffic == nil {
+		req.Traffic = []ServerlessTrafficTarget{}
+	}
+	var resp showServerlessResponse
+	if err := c.doRequest(ctx, "PUT", fmt.Sprintf("/serverless/%s/traffic", id), req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.Container, nil
+}
@@ -0,0 +1,127 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestClient_ListServerlessRevisions(t *testing.T) {
+	server := newTestServer(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/serverless/srv-123/revisions" {
+			t.Errorf("Path = %v, want /serverless/srv-123/revisions", r.URL.Path)
+		}
+
+		page := r.URL.Query().Get("page")
+		w.Header().Set("Content-Type", "application/json")
+		switch page {
+		case "1":
+			_ = json.NewEncoder(w).Encode(listServerlessRevisionsResponse{
+				Data: []ServerlessRevision{
+					{ID: "rev-3", Number: 3, ImageTag: "v3", Status: "ready", TrafficPercent: 90},
+					{ID: "rev-2", Number: 2, ImageTag: "v2", Status: "ready", TrafficPercent: 10},
+				},
+				Pagination: Pagination{CurrentPage: 1, LastPage: 2},
+			})
+		case "2":
+			_ = json.NewEncoder(w).Encode(listServerlessRevisionsResponse{
+				Data:       []ServerlessRevision{{ID: "rev-1", Number: 1, ImageTag: "v1", Status: "retired"}},
+				Pagination: Pagination{CurrentPage: 2, LastPage: 2},
+			})
+		default:
+			t.Errorf("unexpected page %q", page)
+		}
+	})
+	defer server.Close()
+
+	c := newTestClient(server)
+	revisions, err := c.ListServerlessRevisions(context.Background(), "srv-123")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(revisions) != 3 {
+		t.Fatalf("len(revisions) = %d, want 3", len(revisions))
+	}
+	if revisions[0].Number != 3 || revisions[0].TrafficPercent != 90 {
+		t.Errorf("revisions[0] = %+v, want number 3 with 90%% traffic", revisions[0])
+	}
+	if revisions[2].Status != "retired" {
+		t.Errorf("revisions[2].Status = %v, want retired", revisions[2].Status)
+	}
+}
+
+func TestClient_SetServerlessTraffic(t *testing.T) {
+	server := newTestServer(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "PUT" {
+			t.Errorf("Method = %v, want PUT", r.Method)
+		}
+		if r.URL.Path != "/serverless/srv-123/traffic" {
+			t.Errorf("Path = %v, want /serverless/srv-123/traffic", r.URL.Path)
+		}
+
+		var req SetServerlessTrafficRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+		if len(req.Traffic) != 2 {
+			t.Fatalf("len(Traffic) = %d, want 2", len(req.Traffic))
+		}
+		if req.Traffic[0].Revision == nil || *req.Traffic[0].Revision != 2 || req.Traffic[0].Percent != 90 {
+			t.Errorf("Traffic[0] = %+v, want revision 2 at 90%%", req.Traffic[0])
+		}
+		if !req.Traffic[1].LatestRevision || req.Traffic[1].Percent != 10 {
+			t.Errorf("Traffic[1] = %+v, want latest revision at 10%%", req.Traffic[1])
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(showServerlessResponse{
+			Container: ServerlessContainer{ID: "srv-123", Revision: 3, Traffic: req.Traffic},
+		})
+	})
+	defer server.Close()
+
+	c := newTestClient(server)
+	revision := 2
+	container, err := c.SetServerlessTraffic(context.Background(), "srv-123", SetServerlessTrafficRequest{
+		Traffic: []ServerlessTrafficTarget{
+			{Revision: &revision, Percent: 90},
+			{LatestRevision: true, Percent: 10},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(container.Traffic) != 2 {
+		t.Errorf("len(Traffic) = %d, want 2", len(container.Traffic))
+	}
+}
+
+func TestClient_SetServerlessTraffic_Pinned(t *testing.T) {
+	server := newTestServer(func(w http.ResponseWriter, r *http.Request) {
+		var raw map[string]json.RawMessage
+		if err := json.NewDecoder(r.Body).Decode(&raw); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+		if string(raw["pinned_revision"]) != "4" {
+			t.Errorf("pinned_revision = %s, want 4", raw["pinned_revision"])
+		}
+		if string(raw["traffic"]) != "[]" {
+			t.Errorf("traffic = %s, want []", raw["traffic"])
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"container": {"id": "srv-123", "revision": 6, "pinned_revision": 4}}`))
+	})
+	defer server.Close()
+
+	c := newTestClient(server)
+	revision := 4
+	container, err := c.SetServerlessTraffic(context.Background(), "srv-123", SetServerlessTrafficRequest{PinnedRevision: &revision})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if container.PinnedRevision == nil || *container.PinnedRevision != 4 {
+		t.Errorf("PinnedRevision = %v, want 4", container.PinnedRevision)
+	}
+}
@@ -0,0 +1,119 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"mime/multipart"
+	"strings"
+	"time"
+)
+
+// ServerlessBuild represents a build of uploaded source for a zip_upload
+// serverless container.
+type ServerlessBuild struct {
+	ID            string  `json:"id"`
+	Status        string  `json:"status"` // queued, building, succeeded, failed
+	SourceSHA256  string  `json:"source_sha256"`
+	FailureReason *string `json:"failure_reason"`
+	CreatedAt     string  `json:"created_at"`
+	FinishedAt    *string `json:"finished_at"`
+}
+
+type serverlessBuildResponse struct {
+	Message string          `json:"message"`
+	Build   ServerlessBuild `json:"build"`
+}
+
+// UploadServerlessSource uploads a ZIP archive of application source to a
+// zip_upload serverless container and returns the build it started. sha256 is
+// the hex digest of archive; the API rejects the upload if it does not match.
+func (c *Client) UploadServerlessSource(ctx context.Context, id string, archive []byte, sha256 string) (*ServerlessBuild, error) {
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+
+	if err := writer.WriteField("sha256", sha256); err != nil {
+		return nil, fmt.Errorf("failed to encode upload: %w", err)
+	}
+	part, err := writer.CreateFormFile("archive", "source.zip")
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode upload: %w", err)
+	}
+	if _, err := part.Write(archive); err != nil {
+		return nil, fmt.Errorf("failed to encode upload: %w", err)
+	}
+	if err := writer.Close(); err != nil {
+		return nil, fmt.Errorf("failed to encode upload: %w", err)
+	}
+
+	var resp serverlessBuildResponse
+	if err := c.doRawRequest(ctx, "POST", fmt.Sprintf("/serverless/%s/source", id), writer.FormDataContentType(), &body, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.Build, nil
+}
+
+// GetServerlessBuild retrieves a build of a serverless container by ID
+func (c *Client) GetServerlessBuild(ctx context.Context, id, buildID string) (*ServerlessBuild, error) {
+	var resp serverlessBuildResponse
+	if err := c.doRequest(ctx, "GET", fmt.Sprintf("/serverless/%s/builds/%s", id, buildID), nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.Build, nil
+}
+
+// BuildFailedError is returned when a serverless build finishes unsuccessfully.
+// Log holds the tail of the build's log, when it could be fetched.
+type BuildFailedError struct {
+	ContainerID string
+	BuildID     string
+	Reason      string
+	Log         []ServerlessLogLine
+}
+
+func (e *BuildFailedError) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "build %s of serverless container %s failed", e.BuildID, e.ContainerID)
+	if e.Reason != "" {
+		fmt.Fprintf(&b, ": %s", e.Reason)
+	}
+	writeServerlessLog(&b, "build", e.Log)
+	return b.String()
+}
+
+// WaitForServerlessBuild waits for a build to finish. It returns a
+// *BuildFailedError carrying the platform's failure reason if the build fails.
+func (c *Client) WaitForServerlessBuild(ctx context.Context, id, buildID string, timeout time.Duration) (*ServerlessBuild, error) {
+	deadline := time.Now().Add(timeout)
+	ticker := time.NewTicker(5 * time.Second)
+	defer ticker.Stop()
+
+	for {
+		build, err := c.GetServerlessBuild(ctx, id, buildID)
+		if err != nil {
+			return nil, fmt.Errorf("error checking serverless build status: %w", err)
+		}
+
+		switch build.Status {
+		case "succeeded":
+			return build, nil
+		case "failed", "error":
+			reason := ""
+			if build.FailureReason != nil {
+				reason = *build.FailureReason
+			}
+			// The build log is best-effort; the failure reason is reported either way.
+			log, _ := c.GetServerlessLogs(ctx, id, ServerlessLogsQuery{Stream: "build", BuildID: buildID, Limit: serverlessFailureLogLines})
+			return build, &BuildFailedError{ContainerID: id, BuildID: buildID, Reason: reason, Log: log}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+			if time.Now().After(deadline) {
+				return nil, fmt.Errorf("timeout waiting for build %s of serverless container %s to finish", buildID, id)
+			}
+		}
+	}
+}
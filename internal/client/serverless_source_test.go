@@ -0,0 +1,107 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestClient_UploadServerlessSource(t *testing.T) {
+	server := newTestServer(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" {
+			t.Errorf("Method = %v, want POST", r.Method)
+		}
+		if r.URL.Path != "/serverless/sc-123/source" {
+			t.Errorf("Path = %v, want /serverless/sc-123/source", r.URL.Path)
+		}
+		if !strings.HasPrefix(r.Header.Get("Content-Type"), "multipart/form-data") {
+			t.Errorf("Content-Type = %v, want multipart/form-data", r.Header.Get("Content-Type"))
+		}
+
+		if got := r.FormValue("sha256"); got != "abc123" {
+			t.Errorf("sha256 = %v, want abc123", got)
+		}
+		file, _, err := r.FormFile("archive")
+		if err != nil {
+			t.Fatalf("missing archive part: %v", err)
+		}
+		content, _ := io.ReadAll(file)
+		if string(content) != "zip-bytes" {
+			t.Errorf("archive = %q, want zip-bytes", content)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+		_ = json.NewEncoder(w).Encode(serverlessBuildResponse{
+			Message: "Build queued",
+			Build:   ServerlessBuild{ID: "build-1", Status: "queued", SourceSHA256: "abc123"},
+		})
+	})
+	defer server.Close()
+
+	c := newTestClient(server)
+	build, err := c.UploadServerlessSource(context.Background(), "sc-123", []byte("zip-bytes"), "abc123")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if build.ID != "build-1" {
+		t.Errorf("ID = %v, want build-1", build.ID)
+	}
+	if build.Status != "queued" {
+		t.Errorf("Status = %v, want queued", build.Status)
+	}
+}
+
+func TestClient_WaitForServerlessBuild_Succeeded(t *testing.T) {
+	server := newTestServer(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/serverless/sc-123/builds/build-1" {
+			t.Errorf("Path = %v, want /serverless/sc-123/builds/build-1", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(serverlessBuildResponse{
+			Build: ServerlessBuild{ID: "build-1", Status: "succeeded"},
+		})
+	})
+	defer server.Close()
+
+	c := newTestClient(server)
+	build, err := c.WaitForServerlessBuild(context.Background(), "sc-123", "build-1", time.Minute)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if build.Status != "succeeded" {
+		t.Errorf("Status = %v, want succeeded", build.Status)
+	}
+}
+
+func TestClient_WaitForServerlessBuild_FailedWithReason(t *testing.T) {
+	reason := "step 3/7: npm ci exited with code 1"
+	server := newTestServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(serverlessBuildResponse{
+			Build: ServerlessBuild{ID: "build-1", Status: "failed", FailureReason: &reason},
+		})
+	})
+	defer server.Close()
+
+	c := newTestClient(server)
+	_, err := c.WaitForServerlessBuild(context.Background(), "sc-123", "build-1", time.Minute)
+
+	var buildErr *BuildFailedError
+	if !errors.As(err, &buildErr) {
+		t.Fatalf("expected *BuildFailedError, got %T (%v)", err, err)
+	}
+	if buildErr.Reason != reason {
+		t.Errorf("Reason = %v, want %v", buildErr.Reason, reason)
+	}
+	if !strings.Contains(err.Error(), "npm ci exited with code 1") {
+		t.Errorf("error %q does not include the failure reason", err.Error())
+	}
+}
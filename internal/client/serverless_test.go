@@ -275,6 +275,37 @@ func TestClient_UpdateServerless(t *testing.T) {
 	}
 }
 
+func TestClient_UpdateServerless_UnpinsImageDigest(t *testing.T) {
+	server := newTestServer(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+		if digest, ok := body["image_digest"]; !ok || digest != "" {
+			t.Errorf("image_digest = %v (present %v), want empty string", digest, ok)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(showServerlessResponse{
+			Container: ServerlessContainer{ID: "srv-123"},
+		})
+	})
+	defer server.Close()
+
+	c := newTestClient(server)
+	unpin := ""
+	container, err := c.UpdateServerless(context.Background(), "srv-123", UpdateServerlessRequest{
+		ImageDigest: &unpin,
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if container.ImageDigest != nil {
+		t.Errorf("ImageDigest = %v, want nil", *container.ImageDigest)
+	}
+}
+
 func TestClient_DeleteServerless(t *testing.T) {
 	server := newTestServer(func(w http.ResponseWriter, r *http.Request) {
 		if r.Method != "DELETE" {
@@ -434,3 +465,158 @@ func TestClient_CreateServerless_WithEnvVars(t *testing.T) {
 		t.Errorf("EnvironmentVariables count = %v, want 2", len(container.EnvironmentVariables))
 	}
 }
+
+func TestClient_SetServerlessSecrets(t *testing.T) {
+	server := newTestServer(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "PUT" {
+			t.Errorf("Method = %v, want PUT", r.Method)
+		}
+		if r.URL.Path != "/serverless/srv-123/secrets" {
+			t.Errorf("Path = %v, want /serverless/srv-123/secrets", r.URL.Path)
+		}
+
+		var req setServerlessSecretsRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+		if req.Secrets["DB_PASSWORD"] != "s3cret" {
+			t.Errorf("Secrets[DB_PASSWORD] = %v, want s3cret", req.Secrets["DB_PASSWORD"])
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"message": "Secrets updated"}`))
+	})
+	defer server.Close()
+
+	c := newTestClient(server)
+	err := c.SetServerlessSecrets(context.Background(), "srv-123", map[string]string{"DB_PASSWORD": "s3cret"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestClient_SetServerlessSecrets_ClearsWithEmptyObject(t *testing.T) {
+	server := newTestServer(func(w http.ResponseWriter, r *http.Request) {
+		var raw map[string]json.RawMessage
+		if err := json.NewDecoder(r.Body).Decode(&raw); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+		if string(raw["secrets"]) != "{}" {
+			t.Errorf("secrets = %s, want {}", raw["secrets"])
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+	defer server.Close()
+
+	c := newTestClient(server)
+	if err := c.SetServerlessSecrets(context.Background(), "srv-123", nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestClient_GetServerless_SecretHashesOnly(t *testing.T) {
+	server := newTestServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{
+			"container": {
+				"id": "srv-123",
+				"name": "my-app",
+				"environment_variables": {"LOG_LEVEL": "info"},
+				"secret_environment_variable_hashes": {"DB_PASSWORD": "1ec1c26b50d5d3c58d9583181af8076655fe00756bf7285940ba3670f99fcba0"}
+			},
+			"url": "https://my-app.serverless.danubedata.ro",
+			"monthly_cost": 0
+		}`))
+	})
+	defer server.Close()
+
+	c := newTestClient(server)
+	container, err := c.GetServerless(context.Background(), "srv-123")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, ok := container.EnvironmentVariables["DB_PASSWORD"]; ok {
+		t.Error("EnvironmentVariables should not contain secrets")
+	}
+	if len(container.SecretEnvironmentVariableHashes) != 1 {
+		t.Errorf("SecretEnvironmentVariableHashes count = %v, want 1", len(container.SecretEnvironmentVariableHashes))
+	}
+}
+
+func TestClient_CreateServerless_HealthCheckAndAutoscaling(t *testing.T) {
+	server := newTestServer(func(w http.ResponseWriter, r *http.Request) {
+		var req CreateServerlessRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+		if req.HealthCheck == nil || req.HealthCheck.Path != "/healthz" || req.HealthCheck.StartupTimeoutSeconds != 180 {
+			t.Errorf("HealthCheck = %+v, want /healthz with 180s startup timeout", req.HealthCheck)
+		}
+		if req.Autoscaling == nil || req.Autoscaling.TargetConcurrency != 20 {
+			t.Errorf("Autoscaling = %+v, want target concurrency 20", req.Autoscaling)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(createServerlessResponse{
+			Container: ServerlessContainer{
+				ID:          "srv-126",
+				HealthCheck: req.HealthCheck,
+				Autoscaling: req.Autoscaling,
+			},
+		})
+	})
+	defer server.Close()
+
+	c := newTestClient(server)
+	container, err := c.CreateServerless(context.Background(), CreateServerlessRequest{
+		Name:           "jvm-app",
+		DeploymentType: "docker_image",
+		Image:          "ghcr.io/example/jvm-app",
+		HealthCheck: &ServerlessHealthCheck{
+			Path:                  "/healthz",
+			IntervalSeconds:       10,
+			TimeoutSeconds:        5,
+			FailureThreshold:      3,
+			StartupTimeoutSeconds: 180,
+		},
+		Autoscaling: &ServerlessAutoscaling{
+			TargetConcurrency:     20,
+			ScaleDownDelaySeconds: 900,
+			RequestTimeoutSeconds: 60,
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if container.HealthCheck == nil || container.Autoscaling == nil {
+		t.Errorf("HealthCheck/Autoscaling not decoded: %+v", container)
+	}
+}
+
+func TestClient_UpdateServerless_ClearsHealthCheck(t *testing.T) {
+	server := newTestServer(func(w http.ResponseWriter, r *http.Request) {
+		var raw map[string]json.RawMessage
+		if err := json.NewDecoder(r.Body).Decode(&raw); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+		if got, ok := raw["health_check"]; !ok || string(got) != "null" {
+			t.Errorf("health_check = %s (present %v), want null", got, ok)
+		}
+		if got, ok := raw["autoscaling"]; !ok || string(got) == "null" {
+			t.Errorf("autoscaling = %s (present %v), want an object", got, ok)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(showServerlessResponse{Container: ServerlessContainer{ID: "srv-123"}})
+	})
+	defer server.Close()
+
+	c := newTestClient(server)
+	_, err := c.UpdateServerless(context.Background(), "srv-123", UpdateServerlessRequest{
+		Autoscaling: &ServerlessAutoscaling{TargetConcurrency: 50, ScaleDownDelaySeconds: 300, RequestTimeoutSeconds: 300},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
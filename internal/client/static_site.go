@@ -3,6 +3,7 @@ package client
 import (
 	"context"
 	"fmt"
+	"time"
 )
 
 // StaticSite represents a DanubeData static site (pages).
@@ -15,6 +16,43 @@ type StaticSite struct {
 	URL       string `json:"url"`
 	CreatedAt string `json:"created_at"`
 	UpdatedAt string `json:"updated_at"`
+
+	// RedirectToPrimary answers requests on every other domain of the site,
+	// including the default one, with a permanent redirect to the primary.
+	RedirectToPrimary bool `json:"redirect_to_primary"`
+
+	Redirects []StaticSiteRedirect   `json:"redirects"`
+	Headers   []StaticSiteHeaderRule `json:"headers"`
+	BasicAuth *StaticSiteBasicAuth   `json:"basic_auth"`
+}
+
+// StaticSiteRedirect redirects requests whose path matches From. The part of
+// the path matched by the first wildcard of From replaces :splat in To.
+type StaticSiteRedirect struct {
+	From   string `json:"from"`
+	To     string `json:"to"`
+	Status int    `json:"status"` // 301, 302, 307 or 308
+}
+
+// StaticSiteHeaderRule adds response headers to every path matching Path.
+type StaticSiteHeaderRule struct {
+	Path   string            `json:"path"`
+	Values map[string]string `json:"values"`
+}
+
+// StaticSiteBasicAuth protects a whole site with HTTP basic authentication.
+// The API never returns the password.
+type StaticSiteBasicAuth struct {
+	Username string `json:"username"`
+	Password string `json:"password,omitempty"`
+}
+
+// StaticSiteConfig is the request handling configuration of a static site. It
+// is always replaced as a whole; a nil BasicAuth removes password protection.
+type StaticSiteConfig struct {
+	Redirects []StaticSiteRedirect   `json:"redirects"`
+	Headers   []StaticSiteHeaderRule `json:"headers"`
+	BasicAuth *StaticSiteBasicAuth   `json:"basic_auth"`
 }
 
 // StaticSiteDomainDNSInstructions is the DNS record a domain owner must add to prove
@@ -44,6 +82,21 @@ type CreateStaticSiteRequest struct {
 	Plan *string `json:"plan,omitempty"`
 }
 
+// UpdateStaticSiteRequest is the payload for updating a static site. Nil fields
+// are left unchanged.
+type UpdateStaticSiteRequest struct {
+	Name              *string `json:"name,omitempty"`
+	Plan              *string `json:"plan,omitempty"`
+	RedirectToPrimary *bool   `json:"redirect_to_primary,omitempty"`
+}
+
+// UpdateStaticSiteDomainRequest is the payload for updating a custom domain.
+// Making a domain primary demotes the previous primary; unsetting it makes the
+// site's default domain primary again.
+type UpdateStaticSiteDomainRequest struct {
+	IsPrimary bool `json:"is_primary"`
+}
+
 // AddStaticSiteDomainRequest is the payload for adding a custom domain.
 type AddStaticSiteDomainRequest struct {
 	Domain string `json:"domain"`
@@ -104,6 +157,26 @@ func (c *Client) ListStaticSites(ctx context.Context, teamID int) ([]StaticSite,
 	return all, nil
 }
 
+// UpdateStaticSite updates a static site in place. Changing the plan keeps the
+// site's ID, URL, domains and deployments.
+func (c *Client) UpdateStaticSite(ctx context.Context, id string, req UpdateStaticSiteRequest) (*StaticSite, error) {
+	var resp staticSiteResponse
+	if err := c.doRequest(ctx, "PUT", fmt.Sprintf("/static-sites/%s", id), req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.Data, nil
+}
+
+// SetStaticSiteConfig replaces the redirects, headers and basic auth settings
+// of a static site.
+func (c *Client) SetStaticSiteConfig(ctx context.Context, id string, config StaticSiteConfig) (*StaticSite, error) {
+	var resp staticSiteResponse
+	if err := c.doRequest(ctx, "PUT", fmt.Sprintf("/static-sites/%s/config", id), config, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.Data, nil
+}
+
 // DeleteStaticSite deletes a static site.
 func (c *Client) DeleteStaticSite(ctx context.Context, id string) error {
 	return c.doRequest(ctx, "DELETE", fmt.Sprintf("/static-sites/%s", id), nil, nil)
@@ -132,6 +205,15 @@ func (c *Client) DeleteStaticSiteDomain(ctx context.Context, siteID, domainID st
 	return c.doRequest(ctx, "DELETE", fmt.Sprintf("/static-sites/%s/domains/%s", siteID, domainID), nil, nil)
 }
 
+// UpdateStaticSiteDomain updates a custom domain of a static site.
+func (c *Client) UpdateStaticSiteDomain(ctx context.Context, siteID, domainID string, req UpdateStaticSiteDomainRequest) (*StaticSiteDomain, error) {
+	var resp staticSiteDomainResponse
+	if err := c.doRequest(ctx, "PUT", fmt.Sprintf("/static-sites/%s/domains/%s", siteID, domainID), req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.Data, nil
+}
+
 // VerifyStaticSiteDomain triggers verification of a custom domain.
 func (c *Client) VerifyStaticSiteDomain(ctx context.Context, siteID, domainID string) error {
 	return c.doRequest(ctx, "POST", fmt.Sprintf("/static-sites/%s/domains/%s/verify", siteID, domainID), nil, nil)
@@ -150,3 +232,24 @@ func (c *Client) FindStaticSiteDomain(ctx context.Context, siteID, domain string
 	}
 	return nil, &NotFoundError{Resource: "static site domain", ID: domain}
 }
+
+// WaitForStaticSiteDomain waits for a custom domain to be verified and, when
+// waitForTLS is set, for its TLS certificate to be issued. It returns the
+// domain as last seen, also on error.
+func (c *Client) WaitForStaticSiteDomain(ctx context.Context, siteID, domain string, waitForTLS bool, timeout time.Duration) (*StaticSiteDomain, error) {
+	var last *StaticSiteDomain
+	find := func(ctx context.Context) (customDomainState, error) {
+		d, err := c.FindStaticSiteDomain(ctx, siteID, domain)
+		if err != nil {
+			return customDomainState{}, err
+		}
+		last = d
+		return customDomainState{ID: d.ID, VerificationStatus: d.VerificationStatus, TLSStatus: d.TLSStatus, DNSInstructions: d.DNSInstructions}, nil
+	}
+	verify := func(ctx context.Context, id string) error {
+		return c.VerifyStaticSiteDomain(ctx, siteID, id)
+	}
+
+	err := waitForCustomDomain(ctx, domain, waitForTLS, timeout, find, verify)
+	return last, err
+}
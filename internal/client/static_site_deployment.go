@@ -0,0 +1,77 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+)
+
+// StaticSiteManifestFile is one file of a static site deployment, identified by
+// the hex SHA-256 of its content.
+type StaticSiteManifestFile struct {
+	Path   string `json:"path"`
+	SHA256 string `json:"sha256"`
+	Size   int64  `json:"size"`
+}
+
+// StaticSiteDeployment is an immutable snapshot of a static site's content.
+type StaticSiteDeployment struct {
+	ID             string  `json:"id"`
+	Status         string  `json:"status"` // uploading, ready, active, superseded, failed
+	ManifestSHA256 string  `json:"manifest_sha256"`
+	FileCount      int     `json:"file_count"`
+	TotalBytes     int64   `json:"total_bytes"`
+	CreatedAt      string  `json:"created_at"`
+	ActivatedAt    *string `json:"activated_at"`
+
+	// MissingFiles lists the SHA-256 of every file in the manifest the
+	// platform does not already store for this site. Only these need to be
+	// uploaded; the rest are reused from earlier deployments.
+	MissingFiles []string `json:"missing_files"`
+}
+
+type createStaticSiteDeploymentRequest struct {
+	Files []StaticSiteManifestFile `json:"files"`
+}
+
+type staticSiteDeploymentResponse struct {
+	Message string               `json:"message"`
+	Data    StaticSiteDeployment `json:"data"`
+}
+
+// CreateStaticSiteDeployment starts a deployment from a manifest. The returned
+// deployment lists the files that still have to be uploaded.
+func (c *Client) CreateStaticSiteDeployment(ctx context.Context, siteID string, files []StaticSiteManifestFile) (*StaticSiteDeployment, error) {
+	var resp staticSiteDeploymentResponse
+	if err := c.doRequest(ctx, "POST", fmt.Sprintf("/static-sites/%s/deployments", siteID), createStaticSiteDeploymentRequest{Files: files}, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.Data, nil
+}
+
+// UploadStaticSiteFile uploads the content of one file of a deployment. The
+// API rejects content whose SHA-256 does not match sha256.
+func (c *Client) UploadStaticSiteFile(ctx context.Context, siteID, deploymentID, sha256 string, content []byte) error {
+	path := fmt.Sprintf("/static-sites/%s/deployments/%s/files/%s", siteID, deploymentID, sha256)
+	return c.doRawRequest(ctx, "PUT", path, "application/octet-stream", bytes.NewReader(content), nil)
+}
+
+// ActivateStaticSiteDeployment atomically switches the site to serve a
+// deployment. It fails if any file of the manifest has not been uploaded.
+func (c *Client) ActivateStaticSiteDeployment(ctx context.Context, siteID, deploymentID string) (*StaticSiteDeployment, error) {
+	var resp staticSiteDeploymentResponse
+	if err := c.doRequest(ctx, "POST", fmt.Sprintf("/static-sites/%s/deployments/%s/activate", siteID, deploymentID), nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.Data, nil
+}
+
+// GetActiveStaticSiteDeployment retrieves the deployment a site is currently
+// serving. The error satisfies IsNotFound if nothing has been deployed yet.
+func (c *Client) GetActiveStaticSiteDeployment(ctx context.Context, siteID string) (*StaticSiteDeployment, error) {
+	var resp staticSiteDeploymentResponse
+	if err := c.doRequest(ctx, "GET", fmt.Sprintf("/static-sites/%s/deployments/active", siteID), nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.Data, nil
+}
@@ -3,8 +3,11 @@ package client
 import (
 	"context"
 	"encoding/json"
+	"io"
 	"net/http"
+	"strings"
 	"testing"
+	"time"
 )
 
 func TestClient_CreateStaticSite(t *testing.T) {
@@ -95,6 +98,130 @@ func TestClient_DeleteStaticSite(t *testing.T) {
 	}
 }
 
+func TestClient_UpdateStaticSite(t *testing.T) {
+	server := newTestServer(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "PUT" {
+			t.Errorf("Method = %v, want PUT", r.Method)
+		}
+		if r.URL.Path != "/static-sites/site-123" {
+			t.Errorf("Path = %v, want /static-sites/site-123", r.URL.Path)
+		}
+
+		var body map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+		if body["plan"] != "pro" {
+			t.Errorf("plan = %v, want pro", body["plan"])
+		}
+		if _, ok := body["name"]; ok {
+			t.Error("name should be omitted when unchanged")
+		}
+		if body["redirect_to_primary"] != false {
+			t.Errorf("redirect_to_primary = %v, want false", body["redirect_to_primary"])
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(staticSiteResponse{
+			Data: StaticSite{ID: "site-123", Name: "my-site", Plan: "pro"},
+		})
+	})
+	defer server.Close()
+
+	c := newTestClient(server)
+	plan := "pro"
+	redirect := false
+	site, err := c.UpdateStaticSite(context.Background(), "site-123", UpdateStaticSiteRequest{Plan: &plan, RedirectToPrimary: &redirect})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if site.Plan != "pro" {
+		t.Errorf("Plan = %v, want pro", site.Plan)
+	}
+}
+
+func TestClient_SetStaticSiteConfig(t *testing.T) {
+	server := newTestServer(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "PUT" {
+			t.Errorf("Method = %v, want PUT", r.Method)
+		}
+		if r.URL.Path != "/static-sites/site-123/config" {
+			t.Errorf("Path = %v, want /static-sites/site-123/config", r.URL.Path)
+		}
+
+		var body map[string]json.RawMessage
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+		// Cleared settings must be sent explicitly, not omitted.
+		if string(body["headers"]) != "[]" {
+			t.Errorf("headers = %s, want []", body["headers"])
+		}
+		if string(body["basic_auth"]) != "null" {
+			t.Errorf("basic_auth = %s, want null", body["basic_auth"])
+		}
+		var redirects []StaticSiteRedirect
+		if err := json.Unmarshal(body["redirects"], &redirects); err != nil {
+			t.Fatalf("failed to decode redirects: %v", err)
+		}
+		if len(redirects) != 1 || redirects[0].Status != 302 {
+			t.Errorf("redirects = %+v, want one 302 redirect", redirects)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(staticSiteResponse{
+			Data: StaticSite{ID: "site-123", Redirects: redirects},
+		})
+	})
+	defer server.Close()
+
+	c := newTestClient(server)
+	site, err := c.SetStaticSiteConfig(context.Background(), "site-123", StaticSiteConfig{
+		Redirects: []StaticSiteRedirect{{From: "/blog/*", To: "/articles/:splat", Status: 302}},
+		Headers:   []StaticSiteHeaderRule{},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(site.Redirects) != 1 {
+		t.Errorf("Redirects = %+v, want 1", site.Redirects)
+	}
+}
+
+func TestClient_UpdateStaticSiteDomain(t *testing.T) {
+	server := newTestServer(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "PUT" {
+			t.Errorf("Method = %v, want PUT", r.Method)
+		}
+		if r.URL.Path != "/static-sites/site-123/domains/dom-1" {
+			t.Errorf("Path = %v, want /static-sites/site-123/domains/dom-1", r.URL.Path)
+		}
+
+		var body map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+		if body["is_primary"] != true {
+			t.Errorf("is_primary = %v, want true", body["is_primary"])
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(staticSiteDomainResponse{
+			Data: StaticSiteDomain{ID: "dom-1", Domain: "www.example.com", IsPrimary: true},
+		})
+	})
+	defer server.Close()
+
+	c := newTestClient(server)
+	domain, err := c.UpdateStaticSiteDomain(context.Background(), "site-123", "dom-1", UpdateStaticSiteDomainRequest{IsPrimary: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !domain.IsPrimary {
+		t.Error("IsPrimary = false, want true")
+	}
+}
+
 func TestClient_AddStaticSiteDomain(t *testing.T) {
 	server := newTestServer(func(w http.ResponseWriter, r *http.Request) {
 		if r.Method != "POST" {
@@ -192,3 +319,134 @@ func TestClient_DeleteStaticSiteDomain(t *testing.T) {
 		t.Fatalf("unexpected error: %v", err)
 	}
 }
+
+func TestClient_CreateStaticSiteDeployment(t *testing.T) {
+	server := newTestServer(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" {
+			t.Errorf("Method = %v, want POST", r.Method)
+		}
+		if r.URL.Path != "/static-sites/site-123/deployments" {
+			t.Errorf("Path = %v, want /static-sites/site-123/deployments", r.URL.Path)
+		}
+
+		var req createStaticSiteDeploymentRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+		if len(req.Files) != 2 || req.Files[0].Path != "index.html" {
+			t.Errorf("Files = %+v, want index.html first", req.Files)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(staticSiteDeploymentResponse{
+			Data: StaticSiteDeployment{ID: "dep-1", Status: "uploading", MissingFiles: []string{"aaa"}},
+		})
+	})
+	defer server.Close()
+
+	c := newTestClient(server)
+	d, err := c.CreateStaticSiteDeployment(context.Background(), "site-123", []StaticSiteManifestFile{
+		{Path: "index.html", SHA256: "aaa", Size: 10},
+		{Path: "logo.svg", SHA256: "bbb", Size: 20},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(d.MissingFiles) != 1 || d.MissingFiles[0] != "aaa" {
+		t.Errorf("MissingFiles = %v, want [aaa]", d.MissingFiles)
+	}
+}
+
+func TestClient_UploadStaticSiteFile(t *testing.T) {
+	server := newTestServer(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "PUT" {
+			t.Errorf("Method = %v, want PUT", r.Method)
+		}
+		if r.URL.Path != "/static-sites/site-123/deployments/dep-1/files/aaa" {
+			t.Errorf("Path = %v, want /static-sites/site-123/deployments/dep-1/files/aaa", r.URL.Path)
+		}
+		if ct := r.Header.Get("Content-Type"); ct != "application/octet-stream" {
+			t.Errorf("Content-Type = %v, want application/octet-stream", ct)
+		}
+		body, _ := io.ReadAll(r.Body)
+		if string(body) != "<h1>hi</h1>" {
+			t.Errorf("body = %q, want <h1>hi</h1>", body)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+	defer server.Close()
+
+	c := newTestClient(server)
+	if err := c.UploadStaticSiteFile(context.Background(), "site-123", "dep-1", "aaa", []byte("<h1>hi</h1>")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestClient_GetActiveStaticSiteDeployment_NotFound(t *testing.T) {
+	server := newTestServer(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/static-sites/site-123/deployments/active" {
+			t.Errorf("Path = %v, want /static-sites/site-123/deployments/active", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"message":"No active deployment"}`))
+	})
+	defer server.Close()
+
+	c := newTestClient(server)
+	_, err := c.GetActiveStaticSiteDeployment(context.Background(), "site-123")
+	if !IsNotFound(err) {
+		t.Errorf("expected a not found error, got %v", err)
+	}
+}
+
+func TestClient_WaitForStaticSiteDomain_VerificationFailed(t *testing.T) {
+	server := newTestServer(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/static-sites/site-123/domains" {
+			t.Errorf("Path = %v, want /static-sites/site-123/domains", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(listStaticSiteDomainsResponse{
+			Data: []StaticSiteDomain{{
+				ID:                 "domain-99",
+				Domain:             "www.example.com",
+				VerificationStatus: "failed",
+				DNSInstructions: StaticSiteDomainDNSInstructions{
+					RecordType:  "TXT",
+					RecordName:  "_danubedata-verify.www.example.com",
+					RecordValue: "abc123token",
+				},
+			}},
+		})
+	})
+	defer server.Close()
+
+	c := newTestClient(server)
+	d, err := c.WaitForStaticSiteDomain(context.Background(), "site-123", "www.example.com", true, time.Minute)
+	want := `check that the TXT record _danubedata-verify.www.example.com has the value "abc123token"`
+	if err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("error = %v, want it to contain %q", err, want)
+	}
+	if d == nil || d.ID != "domain-99" {
+		t.Errorf("domain = %+v, want the last seen domain", d)
+	}
+}
+
+func TestClient_WaitForStaticSiteDomain_TLSActive(t *testing.T) {
+	server := newTestServer(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" {
+			t.Errorf("unexpected %s %s", r.Method, r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(listStaticSiteDomainsResponse{
+			Data: []StaticSiteDomain{{ID: "domain-99", Domain: "www.example.com", VerificationStatus: "verified", TLSStatus: "active"}},
+		})
+	})
+	defer server.Close()
+
+	c := newTestClient(server)
+	if _, err := c.WaitForStaticSiteDomain(context.Background(), "site-123", "www.example.com", true, time.Minute); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
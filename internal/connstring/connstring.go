@@ -0,0 +1,260 @@
+// Package connstring builds ready-to-use connection strings for database and
+// cache instances in the formats common client libraries expect, so that
+// consumers do not have to parse connection_info themselves.
+package connstring
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Connection string formats.
+const (
+	FormatURI    = "uri"
+	FormatLibpq  = "libpq"
+	FormatJDBC   = "jdbc"
+	FormatGo     = "go"
+	FormatRediss = "rediss"
+)
+
+// TLS modes, named after libpq's sslmode values.
+const (
+	TLSDisable    = "disable"
+	TLSRequire    = "require"
+	TLSVerifyFull = "verify-full"
+)
+
+// Details are the parts a connection string is built from. Engine is a
+// database engine (mysql, mariadb, postgresql) or a cache provider (redis,
+// valkey, dragonfly). TLSMode is empty when it is not known, in which case no
+// TLS parameter is added.
+type Details struct {
+	Engine   string
+	Host     string
+	Port     int
+	Username string
+	Password string
+	Database string
+	TLSMode  string
+}
+
+// IsCache reports whether engine is a cache provider.
+func IsCache(engine string) bool {
+	switch engine {
+	case "redis", "valkey", "dragonfly":
+		return true
+	}
+	return false
+}
+
+// Formats returns the formats available for an engine, sorted. The rediss
+// format is left out for caches with TLS disabled.
+func Formats(engine, tlsMode string) []string {
+	var formats []string
+	switch {
+	case engine == "postgresql":
+		formats = []string{FormatGo, FormatJDBC, FormatLibpq, FormatURI}
+	case engine == "mysql" || engine == "mariadb":
+		formats = []string{FormatGo, FormatJDBC, FormatURI}
+	case IsCache(engine):
+		formats = []string{FormatURI}
+		if tlsMode != TLSDisable {
+			formats = append(formats, FormatRediss)
+		}
+	}
+	sort.Strings(formats)
+	return formats
+}
+
+// Build returns the connection string of d in the given format.
+func Build(d Details, format string) (string, error) {
+	if d.Host == "" {
+		return "", fmt.Errorf("host is required")
+	}
+	if d.TLSMode != "" && d.TLSMode != TLSDisable && d.TLSMode != TLSRequire && d.TLSMode != TLSVerifyFull {
+		return "", fmt.Errorf("unsupported TLS mode %q", d.TLSMode)
+	}
+
+	switch {
+	case d.Engine == "postgresql":
+		switch format {
+		case FormatURI, FormatGo:
+			return postgresURI(d), nil
+		case FormatLibpq:
+			return postgresKeywords(d), nil
+		case FormatJDBC:
+			return jdbcURL("postgresql", d, "sslmode", d.TLSMode), nil
+		}
+	case d.Engine == "mysql" || d.Engine == "mariadb":
+		switch format {
+		case FormatURI:
+			return mysqlURI(d), nil
+		case FormatGo:
+			return mysqlGoDSN(d), nil
+		case FormatJDBC:
+			if d.Engine == "mariadb" {
+				return jdbcURL("mariadb", d, "sslMode", mariadbJDBCSSLModes[d.TLSMode]), nil
+			}
+			return jdbcURL("mysql", d, "sslMode", mysqlSSLModes[d.TLSMode]), nil
+		}
+	case IsCache(d.Engine):
+		switch format {
+		case FormatURI:
+			if d.TLSMode == TLSRequire || d.TLSMode == TLSVerifyFull {
+				return redisURL("rediss", d), nil
+			}
+			return redisURL("redis", d), nil
+		case FormatRediss:
+			return redisURL("rediss", d), nil
+		}
+	default:
+		return "", fmt.Errorf("unsupported engine %q", d.Engine)
+	}
+
+	return "", fmt.Errorf("format %q is not available for %s; available formats: %s",
+		format, d.Engine, strings.Join(Formats(d.Engine, d.TLSMode), ", "))
+}
+
+// BuildAll returns the connection strings of d in every format available for
+// its engine, keyed by format.
+func BuildAll(d Details) (map[string]string, error) {
+	result := make(map[string]string)
+	for _, format := range Formats(d.Engine, d.TLSMode) {
+		s, err := Build(d, format)
+		if err != nil {
+			return nil, err
+		}
+		result[format] = s
+	}
+	return result, nil
+}
+
+var mysqlSSLModes = map[string]string{
+	TLSDisable:    "DISABLED",
+	TLSRequire:    "REQUIRED",
+	TLSVerifyFull: "VERIFY_IDENTITY",
+}
+
+var mariadbJDBCSSLModes = map[string]string{
+	TLSDisable:    "disable",
+	TLSRequire:    "trust",
+	TLSVerifyFull: "verify-full",
+}
+
+// mysqlGoTLS maps TLS modes to the tls parameter of github.com/go-sql-driver/mysql.
+var mysqlGoTLS = map[string]string{
+	TLSDisable:    "false",
+	TLSRequire:    "skip-verify",
+	TLSVerifyFull: "true",
+}
+
+func hostPort(d Details) string {
+	return net.JoinHostPort(d.Host, strconv.Itoa(d.Port))
+}
+
+func userinfo(d Details) *url.Userinfo {
+	if d.Password == "" {
+		if d.Username == "" {
+			return nil
+		}
+		return url.User(d.Username)
+	}
+	return url.UserPassword(d.Username, d.Password)
+}
+
+func postgresURI(d Details) string {
+	u := url.URL{Scheme: "postgresql", User: userinfo(d), Host: hostPort(d), Path: "/" + d.Database}
+	if d.TLSMode != "" {
+		u.RawQuery = url.Values{"sslmode": {d.TLSMode}}.Encode()
+	}
+	return u.String()
+}
+
+// postgresKeywords returns a libpq keyword/value connection string. Values
+// are quoted as libpq requires when they are empty or contain spaces, quotes
+// or backslashes.
+func postgresKeywords(d Details) string {
+	pairs := [][2]string{
+		{"host", d.Host},
+		{"port", strconv.Itoa(d.Port)},
+		{"user", d.Username},
+		{"password", d.Password},
+		{"dbname", d.Database},
+		{"sslmode", d.TLSMode},
+	}
+	parts := make([]string, 0, len(pairs))
+	for _, pair := range pairs {
+		if pair[1] == "" {
+			continue
+		}
+		parts = append(parts, pair[0]+"="+libpqQuote(pair[1]))
+	}
+	return strings.Join(parts, " ")
+}
+
+func libpqQuote(value string) string {
+	if !strings.ContainsAny(value, ` '\`) {
+		return value
+	}
+	value = strings.ReplaceAll(value, `\`, `\\`)
+	value = strings.ReplaceAll(value, `'`, `\'`)
+	return "'" + value + "'"
+}
+
+func jdbcURL(subprotocol string, d Details, sslParam, sslValue string) string {
+	query := url.Values{}
+	if d.Username != "" {
+		query.Set("user", d.Username)
+	}
+	if d.Password != "" {
+		query.Set("password", d.Password)
+	}
+	if sslValue != "" {
+		query.Set(sslParam, sslValue)
+	}
+
+	s := fmt.Sprintf("jdbc:%s://%s/%s", subprotocol, hostPort(d), url.PathEscape(d.Database))
+	if len(query) > 0 {
+		s += "?" + query.Encode()
+	}
+	return s
+}
+
+func mysqlURI(d Details) string {
+	u := url.URL{Scheme: "mysql", User: userinfo(d), Host: hostPort(d), Path: "/" + d.Database}
+	if mode := mysqlSSLModes[d.TLSMode]; mode != "" {
+		u.RawQuery = url.Values{"ssl-mode": {mode}}.Encode()
+	}
+	return u.String()
+}
+
+// mysqlGoDSN returns a data source name for github.com/go-sql-driver/mysql,
+// e.g. "user:password@tcp(host:3306)/db?tls=true". The driver splits the
+// credentials at the last '@', so the password needs no escaping.
+func mysqlGoDSN(d Details) string {
+	var b strings.Builder
+	if d.Username != "" || d.Password != "" {
+		b.WriteString(d.Username)
+		if d.Password != "" {
+			b.WriteString(":" + d.Password)
+		}
+		b.WriteString("@")
+	}
+	fmt.Fprintf(&b, "tcp(%s)/%s", hostPort(d), d.Database)
+	if tls := mysqlGoTLS[d.TLSMode]; tls != "" {
+		b.WriteString("?tls=" + tls)
+	}
+	return b.String()
+}
+
+func redisURL(scheme string, d Details) string {
+	u := url.URL{Scheme: scheme, User: userinfo(d), Host: hostPort(d)}
+	if d.Database != "" {
+		u.Path = "/" + d.Database
+	}
+	return u.String()
+}
@@ -0,0 +1,144 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/AdrianSilaghi/terraform-provider-danubedata/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &DatabaseBackupsDataSource{}
+var _ datasource.DataSourceWithConfigure = &DatabaseBackupsDataSource{}
+
+type DatabaseBackupsDataSource struct {
+	client *client.Client
+}
+
+type DatabaseBackupsDataSourceModel struct {
+	DatabaseID             types.String          `tfsdk:"database_id"`
+	Backups                []DatabaseBackupModel `tfsdk:"backups"`
+	EarliestRestorableTime types.String          `tfsdk:"earliest_restorable_time"`
+	LatestRestorableTime   types.String          `tfsdk:"latest_restorable_time"`
+}
+
+type DatabaseBackupModel struct {
+	ID          types.String  `tfsdk:"id"`
+	Type        types.String  `tfsdk:"type"`
+	Status      types.String  `tfsdk:"status"`
+	SizeGB      types.Float64 `tfsdk:"size_gb"`
+	StartedAt   types.String  `tfsdk:"started_at"`
+	CompletedAt types.String  `tfsdk:"completed_at"`
+}
+
+func NewDatabaseBackupsDataSource() datasource.DataSource {
+	return &DatabaseBackupsDataSource{}
+}
+
+func (d *DatabaseBackupsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_database_backups"
+}
+
+func (d *DatabaseBackupsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the automated backups of a database instance and the range of times it can be restored to.",
+		Attributes: map[string]schema.Attribute{
+			"database_id": schema.StringAttribute{
+				Description: "ID of the database instance.",
+				Required:    true,
+			},
+			"backups": schema.ListNestedAttribute{
+				Description: "Automated backups, as returned by the API.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "Backup ID.",
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "Backup type (full, incremental).",
+							Computed:    true,
+						},
+						"status": schema.StringAttribute{
+							Description: "Backup status (running, completed, failed).",
+							Computed:    true,
+						},
+						"size_gb": schema.Float64Attribute{
+							Description: "Backup size in GB.",
+							Computed:    true,
+						},
+						"started_at": schema.StringAttribute{
+							Description: "Time the backup started.",
+							Computed:    true,
+						},
+						"completed_at": schema.StringAttribute{
+							Description: "Time the backup completed. Null while it is running.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"earliest_restorable_time": schema.StringAttribute{
+				Description: "Earliest time restore_to_time can be set to. Null while there is no completed backup.",
+				Computed:    true,
+			},
+			"latest_restorable_time": schema.StringAttribute{
+				Description: "Latest time restore_to_time can be set to. Null while there is no completed backup.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *DatabaseBackupsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+	d.client = c
+}
+
+func (d *DatabaseBackupsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if d.client == nil {
+		resp.Diagnostics.AddError("Unconfigured Client", "Expected configured client.")
+		return
+	}
+
+	var data DatabaseBackupsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	list, err := d.client.ListDatabaseBackups(ctx, data.DatabaseID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list database backups", err.Error())
+		return
+	}
+
+	data.Backups = make([]DatabaseBackupModel, len(list.Backups))
+	for i, b := range list.Backups {
+		data.Backups[i] = DatabaseBackupModel{
+			ID:          types.StringValue(b.ID),
+			Type:        types.StringValue(b.Type),
+			Status:      types.StringValue(b.Status),
+			SizeGB:      types.Float64Value(b.SizeGB),
+			StartedAt:   types.StringValue(b.StartedAt),
+			CompletedAt: types.StringPointerValue(b.CompletedAt),
+		}
+	}
+	data.EarliestRestorableTime = types.StringPointerValue(list.EarliestRestorableTime)
+	data.LatestRestorableTime = types.StringPointerValue(list.LatestRestorableTime)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
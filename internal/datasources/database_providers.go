@@ -3,6 +3,7 @@ package datasources
 import (
 	"context"
 
+	"github.com/AdrianSilaghi/terraform-provider-danubedata/internal/catalog"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -22,6 +23,7 @@ type DatabaseProviderModel struct {
 	Type        types.String `tfsdk:"type"`
 	Description types.String `tfsdk:"description"`
 	Version     types.String `tfsdk:"version"`
+	Versions    types.List   `tfsdk:"versions"`
 	DefaultPort types.Int64  `tfsdk:"default_port"`
 }
 
@@ -62,6 +64,11 @@ func (d *DatabaseProvidersDataSource) Schema(ctx context.Context, req datasource
 							Description: "Default version.",
 							Computed:    true,
 						},
+						"versions": schema.ListAttribute{
+							Description: "Supported versions, oldest first. An instance can be upgraded to any later version in this list.",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
 						"default_port": schema.Int64Attribute{
 							Description: "Default port number.",
 							Computed:    true,
@@ -76,33 +83,23 @@ func (d *DatabaseProvidersDataSource) Schema(ctx context.Context, req datasource
 func (d *DatabaseProvidersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	// Return static provider information
 	// These match the seeded providers in the DanubeData database
-	data := DatabaseProvidersDataSourceModel{
-		Providers: []DatabaseProviderModel{
-			{
-				ID:          types.Int64Value(1),
-				Name:        types.StringValue("MySQL"),
-				Type:        types.StringValue("mysql"),
-				Description: types.StringValue("World's most popular open source database with proven reliability and performance."),
-				Version:     types.StringValue("8.0"),
-				DefaultPort: types.Int64Value(3306),
-			},
-			{
-				ID:          types.Int64Value(2),
-				Name:        types.StringValue("PostgreSQL"),
-				Type:        types.StringValue("postgresql"),
-				Description: types.StringValue("Advanced open source relational database with powerful features and reliability."),
-				Version:     types.StringValue("16"),
-				DefaultPort: types.Int64Value(5432),
-			},
-			{
-				ID:          types.Int64Value(3),
-				Name:        types.StringValue("MariaDB"),
-				Type:        types.StringValue("mariadb"),
-				Description: types.StringValue("MySQL-compatible database with enhanced features, performance and modern architecture."),
-				Version:     types.StringValue("11.4"),
-				DefaultPort: types.Int64Value(3306),
-			},
-		},
+	var data DatabaseProvidersDataSourceModel
+	for _, engine := range catalog.DatabaseEngines {
+		versions, diags := types.ListValueFrom(ctx, types.StringType, engine.Versions)
+		resp.Diagnostics.Append(diags...)
+
+		data.Providers = append(data.Providers, DatabaseProviderModel{
+			ID:          types.Int64Value(engine.ID),
+			Name:        types.StringValue(engine.Name),
+			Type:        types.StringValue(engine.Type),
+			Description: types.StringValue(engine.Description),
+			Version:     types.StringValue(engine.DefaultVersion),
+			Versions:    versions,
+			DefaultPort: types.Int64Value(engine.DefaultPort),
+		})
+	}
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -0,0 +1,220 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/AdrianSilaghi/terraform-provider-danubedata/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &DatabaseReplicasDataSource{}
+var _ datasource.DataSourceWithConfigure = &DatabaseReplicasDataSource{}
+
+type DatabaseReplicasDataSource struct {
+	client *client.Client
+}
+
+type DatabaseReplicasDataSourceModel struct {
+	DatabaseID          types.String                `tfsdk:"database_id"`
+	Master              *DatabaseReplicaMasterModel `tfsdk:"master"`
+	Replicas            []DatabaseReplicaModel      `tfsdk:"replicas"`
+	HealthyReplicaCount types.Int64                 `tfsdk:"healthy_replica_count"`
+}
+
+type DatabaseReplicaMasterModel struct {
+	Name     types.String `tfsdk:"name"`
+	NodeID   types.String `tfsdk:"node_id"`
+	Endpoint types.String `tfsdk:"endpoint"`
+	Status   types.String `tfsdk:"status"`
+	Ready    types.Bool   `tfsdk:"ready"`
+}
+
+type DatabaseReplicaModel struct {
+	ReplicaIndex         types.Int64  `tfsdk:"replica_index"`
+	Name                 types.String `tfsdk:"name"`
+	NodeID               types.String `tfsdk:"node_id"`
+	Endpoint             types.String `tfsdk:"endpoint"`
+	Status               types.String `tfsdk:"status"`
+	Ready                types.Bool   `tfsdk:"ready"`
+	ReplicationStatus    types.String `tfsdk:"replication_status"`
+	SecondsBehindMaster  types.Int64  `tfsdk:"seconds_behind_master"`
+	IsReplicationHealthy types.Bool   `tfsdk:"is_replication_healthy"`
+}
+
+func NewDatabaseReplicasDataSource() datasource.DataSource {
+	return &DatabaseReplicasDataSource{}
+}
+
+func (d *DatabaseReplicasDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_database_replicas"
+}
+
+func (d *DatabaseReplicasDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the master node and read replicas of a database instance, with replication lag and health.",
+		Attributes: map[string]schema.Attribute{
+			"database_id": schema.StringAttribute{
+				Description: "ID of the database instance.",
+				Required:    true,
+			},
+			"master": schema.SingleNestedAttribute{
+				Description: "The master node, which accepts writes.",
+				Computed:    true,
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						Description: "Name of the master node.",
+						Computed:    true,
+					},
+					"node_id": schema.StringAttribute{
+						Description: "Internal node identifier.",
+						Computed:    true,
+					},
+					"endpoint": schema.StringAttribute{
+						Description: "Connection endpoint of the master.",
+						Computed:    true,
+					},
+					"status": schema.StringAttribute{
+						Description: "Current status of the master.",
+						Computed:    true,
+					},
+					"ready": schema.BoolAttribute{
+						Description: "Whether the master is ready to serve traffic.",
+						Computed:    true,
+					},
+				},
+			},
+			"replicas": schema.ListNestedAttribute{
+				Description: "Read replicas, ordered by replica_index.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"replica_index": schema.Int64Attribute{
+							Description: "1-based index of the replica within the instance.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Name of the replica node.",
+							Computed:    true,
+						},
+						"node_id": schema.StringAttribute{
+							Description: "Internal node identifier.",
+							Computed:    true,
+						},
+						"endpoint": schema.StringAttribute{
+							Description: "Connection endpoint of the replica.",
+							Computed:    true,
+						},
+						"status": schema.StringAttribute{
+							Description: "Current status of the replica.",
+							Computed:    true,
+						},
+						"ready": schema.BoolAttribute{
+							Description: "Whether the replica is ready to serve reads.",
+							Computed:    true,
+						},
+						"replication_status": schema.StringAttribute{
+							Description: "Replication status (healthy, lagging, broken).",
+							Computed:    true,
+						},
+						"seconds_behind_master": schema.Int64Attribute{
+							Description: "Replication lag in seconds behind the master. Null when unknown.",
+							Computed:    true,
+						},
+						"is_replication_healthy": schema.BoolAttribute{
+							Description: "Whether replication is healthy.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"healthy_replica_count": schema.Int64Attribute{
+				Description: "Number of replicas that are ready and replicating healthily.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *DatabaseReplicasDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+	d.client = c
+}
+
+func (d *DatabaseReplicasDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if d.client == nil {
+		resp.Diagnostics.AddError("Unconfigured Client", "Expected configured client.")
+		return
+	}
+
+	var data DatabaseReplicasDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	list, err := d.client.ListDatabaseReplicas(ctx, data.DatabaseID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list database replicas", err.Error())
+		return
+	}
+
+	flattenDatabaseReplicaList(list, &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// flattenDatabaseReplicaList maps a replica listing onto the data source,
+// with replicas ordered by index.
+func flattenDatabaseReplicaList(list *client.DatabaseReplicaList, data *DatabaseReplicasDataSourceModel) {
+	data.Master = &DatabaseReplicaMasterModel{
+		Name:     types.StringValue(list.Master.Name),
+		NodeID:   types.StringValue(list.Master.NodeID),
+		Endpoint: types.StringPointerValue(list.Master.Endpoint),
+		Status:   types.StringValue(list.Master.Status),
+		Ready:    types.BoolValue(list.Master.Ready),
+	}
+
+	data.Replicas = make([]DatabaseReplicaModel, len(list.Replicas))
+	healthy := 0
+	for i, r := range list.Replicas {
+		var lag types.Int64
+		if r.SecondsBehindMaster != nil {
+			lag = types.Int64Value(int64(*r.SecondsBehindMaster))
+		} else {
+			lag = types.Int64Null()
+		}
+
+		data.Replicas[i] = DatabaseReplicaModel{
+			ReplicaIndex:         types.Int64Value(int64(r.ReplicaIndex)),
+			Name:                 types.StringValue(r.Name),
+			NodeID:               types.StringValue(r.NodeID),
+			Endpoint:             types.StringPointerValue(r.Endpoint),
+			Status:               types.StringValue(r.Status),
+			Ready:                types.BoolValue(r.Ready),
+			ReplicationStatus:    types.StringPointerValue(r.ReplicationStatus),
+			SecondsBehindMaster:  lag,
+			IsReplicationHealthy: types.BoolValue(r.IsReplicationHealthy),
+		}
+		if r.Ready && r.IsReplicationHealthy {
+			healthy++
+		}
+	}
+	sort.Slice(data.Replicas, func(i, j int) bool {
+		return data.Replicas[i].ReplicaIndex.ValueInt64() < data.Replicas[j].ReplicaIndex.ValueInt64()
+	})
+	data.HealthyReplicaCount = types.Int64Value(int64(healthy))
+}
@@ -0,0 +1,59 @@
+package datasources
+
+import (
+	"testing"
+
+	"github.com/AdrianSilaghi/terraform-provider-danubedata/internal/client"
+)
+
+func TestFlattenDatabaseReplicaList(t *testing.T) {
+	lag := 3
+	list := &client.DatabaseReplicaList{
+		Master: client.DatabaseReplicaMaster{Name: "app-db", NodeID: "node-0", Status: "running", Ready: true},
+		Replicas: []client.DatabaseReplica{
+			{Name: "app-db-replica-3", ReplicaIndex: 3, Ready: true, IsReplicationHealthy: false},
+			{Name: "app-db-replica-1", ReplicaIndex: 1, Ready: true, IsReplicationHealthy: true, SecondsBehindMaster: &lag},
+			{Name: "app-db-replica-2", ReplicaIndex: 2, Ready: false, IsReplicationHealthy: true},
+			{Name: "app-db-replica-4", ReplicaIndex: 4, Ready: true, IsReplicationHealthy: true},
+		},
+	}
+
+	var data DatabaseReplicasDataSourceModel
+	flattenDatabaseReplicaList(list, &data)
+
+	if data.Master == nil || data.Master.Name.ValueString() != "app-db" || !data.Master.Endpoint.IsNull() {
+		t.Errorf("Master = %+v, want app-db without an endpoint", data.Master)
+	}
+
+	// Only replicas that are both ready and replicating count as healthy.
+	if data.HealthyReplicaCount.ValueInt64() != 2 {
+		t.Errorf("HealthyReplicaCount = %v, want 2", data.HealthyReplicaCount)
+	}
+
+	if len(data.Replicas) != 4 {
+		t.Fatalf("len(Replicas) = %d, want 4", len(data.Replicas))
+	}
+	for i, replica := range data.Replicas {
+		if replica.ReplicaIndex.ValueInt64() != int64(i+1) {
+			t.Errorf("Replicas[%d].ReplicaIndex = %v, want %d", i, replica.ReplicaIndex, i+1)
+		}
+	}
+	if data.Replicas[0].SecondsBehindMaster.ValueInt64() != 3 {
+		t.Errorf("Replicas[0].SecondsBehindMaster = %v, want 3", data.Replicas[0].SecondsBehindMaster)
+	}
+	if !data.Replicas[1].SecondsBehindMaster.IsNull() {
+		t.Errorf("Replicas[1].SecondsBehindMaster = %v, want null", data.Replicas[1].SecondsBehindMaster)
+	}
+}
+
+func TestFlattenDatabaseReplicaList_NoReplicas(t *testing.T) {
+	var data DatabaseReplicasDataSourceModel
+	flattenDatabaseReplicaList(&client.DatabaseReplicaList{}, &data)
+
+	if data.Replicas == nil || len(data.Replicas) != 0 {
+		t.Errorf("Replicas = %v, want an empty list", data.Replicas)
+	}
+	if data.HealthyReplicaCount.ValueInt64() != 0 {
+		t.Errorf("HealthyReplicaCount = %v, want 0", data.HealthyReplicaCount)
+	}
+}
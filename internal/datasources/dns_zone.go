@@ -0,0 +1,123 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/AdrianSilaghi/terraform-provider-danubedata/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &DNSZoneDataSource{}
+var _ datasource.DataSourceWithConfigure = &DNSZoneDataSource{}
+
+type DNSZoneDataSource struct {
+	client *client.Client
+}
+
+type DNSZoneDataSourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Status      types.String `tfsdk:"status"`
+	Nameservers types.List   `tfsdk:"nameservers"`
+	RecordCount types.Int64  `tfsdk:"record_count"`
+	CreatedAt   types.String `tfsdk:"created_at"`
+}
+
+func NewDNSZoneDataSource() datasource.DataSource {
+	return &DNSZoneDataSource{}
+}
+
+func (d *DNSZoneDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns_zone"
+}
+
+func (d *DNSZoneDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Looks up an existing DNS zone by ID or by domain name, e.g. to add records to a zone managed in another configuration.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the zone. Exactly one of id and name must be set.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("name")),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Domain name of the zone, e.g. 'example.com'. Matched case-insensitively, ignoring a trailing dot.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"status": schema.StringAttribute{
+				Description: "Delegation status of the zone (pending, active).",
+				Computed:    true,
+			},
+			"nameservers": schema.ListAttribute{
+				Description: "Nameservers to configure at the domain's registrar.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"record_count": schema.Int64Attribute{
+				Description: "Number of records in the zone.",
+				Computed:    true,
+			},
+			"created_at": schema.StringAttribute{
+				Description: "Timestamp when the zone was created.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *DNSZoneDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+	d.client = c
+}
+
+func (d *DNSZoneDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DNSZoneDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var zone *client.DNSZone
+	var err error
+	if !data.ID.IsNull() {
+		zone, err = d.client.GetDNSZone(ctx, data.ID.ValueString())
+	} else {
+		zone, err = d.client.FindDNSZoneByName(ctx, data.Name.ValueString())
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read DNS zone", err.Error())
+		return
+	}
+
+	nameservers, diags := types.ListValueFrom(ctx, types.StringType, zone.Nameservers)
+	resp.Diagnostics.Append(diags...)
+
+	data.ID = types.StringValue(zone.ID)
+	data.Name = types.StringValue(zone.Name)
+	data.Status = types.StringValue(zone.Status)
+	data.Nameservers = nameservers
+	data.RecordCount = types.Int64Value(int64(zone.RecordCount))
+	data.CreatedAt = types.StringValue(zone.CreatedAt)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
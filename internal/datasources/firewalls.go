@@ -22,12 +22,27 @@ type FirewallsDataSourceModel struct {
 }
 
 type FirewallModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Description types.String `tfsdk:"description"`
-	Status      types.String `tfsdk:"status"`
-	RulesCount  types.Int64  `tfsdk:"rules_count"`
-	CreatedAt   types.String `tfsdk:"created_at"`
+	ID                    types.String        `tfsdk:"id"`
+	Name                  types.String        `tfsdk:"name"`
+	Description           types.String        `tfsdk:"description"`
+	Status                types.String        `tfsdk:"status"`
+	DefaultInboundPolicy  types.String        `tfsdk:"default_inbound_policy"`
+	DefaultOutboundPolicy types.String        `tfsdk:"default_outbound_policy"`
+	RulesCount            types.Int64         `tfsdk:"rules_count"`
+	Rules                 []FirewallRuleModel `tfsdk:"rules"`
+	CreatedAt             types.String        `tfsdk:"created_at"`
+}
+
+type FirewallRuleModel struct {
+	ID             types.String   `tfsdk:"id"`
+	Action         types.String   `tfsdk:"action"`
+	Direction      types.String   `tfsdk:"direction"`
+	Protocol       types.String   `tfsdk:"protocol"`
+	PortRangeStart types.Int64    `tfsdk:"port_range_start"`
+	PortRangeEnd   types.Int64    `tfsdk:"port_range_end"`
+	SourceIPs      []types.String `tfsdk:"source_ips"`
+	SourceIPSetIDs []types.String `tfsdk:"source_ip_set_ids"`
+	DestinationIPs []types.String `tfsdk:"destination_ips"`
 }
 
 func NewFirewallsDataSource() datasource.DataSource {
@@ -63,10 +78,65 @@ func (d *FirewallsDataSource) Schema(ctx context.Context, req datasource.SchemaR
 							Description: "Current status of the firewall.",
 							Computed:    true,
 						},
+						"default_inbound_policy": schema.StringAttribute{
+							Description: "Action applied to inbound traffic that matches no rule.",
+							Computed:    true,
+						},
+						"default_outbound_policy": schema.StringAttribute{
+							Description: "Action applied to outbound traffic that matches no rule.",
+							Computed:    true,
+						},
 						"rules_count": schema.Int64Attribute{
 							Description: "Number of rules in the firewall.",
 							Computed:    true,
 						},
+						"rules": schema.ListNestedAttribute{
+							Description: "Rules of the firewall, in evaluation order.",
+							Computed:    true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"id": schema.StringAttribute{
+										Description: "Rule ID.",
+										Computed:    true,
+									},
+									"action": schema.StringAttribute{
+										Description: "Action taken: 'allow' or 'deny'.",
+										Computed:    true,
+									},
+									"direction": schema.StringAttribute{
+										Description: "Direction: 'inbound' or 'outbound'.",
+										Computed:    true,
+									},
+									"protocol": schema.StringAttribute{
+										Description: "Protocol matched by the rule.",
+										Computed:    true,
+									},
+									"port_range_start": schema.Int64Attribute{
+										Description: "Start of port range.",
+										Computed:    true,
+									},
+									"port_range_end": schema.Int64Attribute{
+										Description: "End of port range.",
+										Computed:    true,
+									},
+									"source_ips": schema.ListAttribute{
+										Description: "Source IP addresses or CIDR blocks.",
+										Computed:    true,
+										ElementType: types.StringType,
+									},
+									"source_ip_set_ids": schema.ListAttribute{
+										Description: "IDs of the IP sets the rule references.",
+										Computed:    true,
+										ElementType: types.StringType,
+									},
+									"destination_ips": schema.ListAttribute{
+										Description: "Destination IP addresses or CIDR blocks (outbound rules).",
+										Computed:    true,
+										ElementType: types.StringType,
+									},
+								},
+							},
+						},
 						"created_at": schema.StringAttribute{
 							Description: "Timestamp when the firewall was created.",
 							Computed:    true,
@@ -115,14 +185,51 @@ func (d *FirewallsDataSource) Read(ctx context.Context, req datasource.ReadReque
 	data.Firewalls = make([]FirewallModel, len(firewalls))
 	for i, fw := range firewalls {
 		data.Firewalls[i] = FirewallModel{
-			ID:          types.StringValue(fw.ID),
-			Name:        types.StringValue(fw.Name),
-			Description: types.StringValue(fw.Description),
-			Status:      types.StringValue(fw.Status),
-			RulesCount:  types.Int64Value(int64(len(fw.Rules))),
-			CreatedAt:   types.StringValue(fw.CreatedAt),
+			ID:                    types.StringValue(fw.ID),
+			Name:                  types.StringValue(fw.Name),
+			Description:           types.StringValue(fw.Description),
+			Status:                types.StringValue(fw.Status),
+			DefaultInboundPolicy:  types.StringValue(fw.DefaultInboundPolicy),
+			DefaultOutboundPolicy: types.StringValue(fw.DefaultOutboundPolicy),
+			RulesCount:            types.Int64Value(int64(len(fw.Rules))),
+			Rules:                 flattenFirewallRules(fw.Rules),
+			CreatedAt:             types.StringValue(fw.CreatedAt),
 		}
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
+
+func flattenFirewallRules(rules []client.FirewallRule) []FirewallRuleModel {
+	models := make([]FirewallRuleModel, len(rules))
+	for i, rule := range rules {
+		model := FirewallRuleModel{
+			ID:             types.StringValue(rule.ID),
+			Action:         types.StringValue(rule.Action),
+			Direction:      types.StringValue(rule.Direction),
+			Protocol:       types.StringValue(rule.Protocol),
+			PortRangeStart: types.Int64Null(),
+			PortRangeEnd:   types.Int64Null(),
+			SourceIPs:      make([]types.String, len(rule.SourceIPs)),
+			SourceIPSetIDs: make([]types.String, len(rule.SourceIPSetIDs)),
+			DestinationIPs: make([]types.String, len(rule.DestinationIPs)),
+		}
+		if rule.PortRangeStart != nil {
+			model.PortRangeStart = types.Int64Value(int64(*rule.PortRangeStart))
+		}
+		if rule.PortRangeEnd != nil {
+			model.PortRangeEnd = types.Int64Value(int64(*rule.PortRangeEnd))
+		}
+		for j, ip := range rule.SourceIPs {
+			model.SourceIPs[j] = types.StringValue(ip)
+		}
+		for j, id := range rule.SourceIPSetIDs {
+			model.SourceIPSetIDs[j] = types.StringValue(id)
+		}
+		for j, ip := range rule.DestinationIPs {
+			model.DestinationIPs[j] = types.StringValue(ip)
+		}
+		models[i] = model
+	}
+	return models
+}
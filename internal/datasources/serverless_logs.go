@@ -0,0 +1,179 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/AdrianSilaghi/terraform-provider-danubedata/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &ServerlessLogsDataSource{}
+var _ datasource.DataSourceWithConfigure = &ServerlessLogsDataSource{}
+
+// defaultServerlessLogLimit is the number of lines returned when limit is unset.
+const defaultServerlessLogLimit = 100
+
+type ServerlessLogsDataSource struct {
+	client *client.Client
+}
+
+type ServerlessLogsDataSourceModel struct {
+	ContainerID types.String         `tfsdk:"container_id"`
+	Stream      types.String         `tfsdk:"stream"`
+	Since       types.String         `tfsdk:"since"`
+	Limit       types.Int64          `tfsdk:"limit"`
+	Lines       []ServerlessLogModel `tfsdk:"lines"`
+	Text        types.String         `tfsdk:"text"`
+}
+
+type ServerlessLogModel struct {
+	Timestamp types.String `tfsdk:"timestamp"`
+	Stream    types.String `tfsdk:"stream"`
+	Message   types.String `tfsdk:"message"`
+}
+
+func NewServerlessLogsDataSource() datasource.DataSource {
+	return &ServerlessLogsDataSource{}
+}
+
+func (d *ServerlessLogsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_serverless_logs"
+}
+
+func (d *ServerlessLogsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the most recent build or runtime log lines of a serverless container.",
+		Attributes: map[string]schema.Attribute{
+			"container_id": schema.StringAttribute{
+				Description: "ID of the serverless container.",
+				Required:    true,
+			},
+			"stream": schema.StringAttribute{
+				Description: "Log stream to read: 'build' or 'runtime'. Defaults to 'runtime'.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("build", "runtime"),
+				},
+			},
+			"since": schema.StringAttribute{
+				Description: "Only return lines logged at or after this RFC 3339 timestamp.",
+				Optional:    true,
+			},
+			"limit": schema.Int64Attribute{
+				Description: "Maximum number of lines to return, counting back from the most recent. Defaults to 100.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 1000),
+				},
+			},
+			"lines": schema.ListNestedAttribute{
+				Description: "Log lines, oldest first.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"timestamp": schema.StringAttribute{
+							Description: "Time the line was logged.",
+							Computed:    true,
+						},
+						"stream": schema.StringAttribute{
+							Description: "Stream the line belongs to (build or runtime).",
+							Computed:    true,
+						},
+						"message": schema.StringAttribute{
+							Description: "Log message.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"text": schema.StringAttribute{
+				Description: "The log lines joined with newlines, each prefixed with its timestamp.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *ServerlessLogsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = c
+}
+
+func (d *ServerlessLogsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if d.client == nil {
+		resp.Diagnostics.AddError(
+			"Unconfigured Client",
+			"Expected configured client. Please report this issue to the provider developers.",
+		)
+		return
+	}
+
+	var data ServerlessLogsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	query := client.ServerlessLogsQuery{
+		Stream: "runtime",
+		Limit:  defaultServerlessLogLimit,
+	}
+	if !data.Stream.IsNull() {
+		query.Stream = data.Stream.ValueString()
+	}
+	if !data.Limit.IsNull() {
+		query.Limit = int(data.Limit.ValueInt64())
+	}
+	if !data.Since.IsNull() {
+		if _, err := time.Parse(time.RFC3339, data.Since.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("since"),
+				"Invalid Timestamp",
+				fmt.Sprintf("since must be an RFC 3339 timestamp such as 2026-01-02T15:04:05Z, got %q.", data.Since.ValueString()),
+			)
+			return
+		}
+		query.Since = data.Since.ValueString()
+	}
+
+	lines, err := d.client.GetServerlessLogs(ctx, data.ContainerID.ValueString(), query)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read serverless logs", err.Error())
+		return
+	}
+
+	data.Lines = make([]ServerlessLogModel, len(lines))
+	text := make([]string, len(lines))
+	for i, line := range lines {
+		data.Lines[i] = ServerlessLogModel{
+			Timestamp: types.StringValue(line.Timestamp),
+			Stream:    types.StringValue(line.Stream),
+			Message:   types.StringValue(line.Message),
+		}
+		text[i] = line.Timestamp + " " + line.Message
+	}
+	data.Text = types.StringValue(strings.Join(text, "\n"))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
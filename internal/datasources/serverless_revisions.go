@@ -0,0 +1,165 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/AdrianSilaghi/terraform-provider-danubedata/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &ServerlessRevisionsDataSource{}
+var _ datasource.DataSourceWithConfigure = &ServerlessRevisionsDataSource{}
+
+type ServerlessRevisionsDataSource struct {
+	client *client.Client
+}
+
+type ServerlessRevisionsDataSourceModel struct {
+	ContainerID types.String              `tfsdk:"container_id"`
+	Revisions   []ServerlessRevisionModel `tfsdk:"revisions"`
+}
+
+type ServerlessRevisionModel struct {
+	ID              types.String `tfsdk:"id"`
+	Number          types.Int64  `tfsdk:"number"`
+	Image           types.String `tfsdk:"image"`
+	ImageTag        types.String `tfsdk:"image_tag"`
+	SourceSHA256    types.String `tfsdk:"source_sha256"`
+	EnvironmentHash types.String `tfsdk:"environment_hash"`
+	Status          types.String `tfsdk:"status"`
+	TrafficPercent  types.Int64  `tfsdk:"traffic_percent"`
+	CreatedAt       types.String `tfsdk:"created_at"`
+}
+
+func NewServerlessRevisionsDataSource() datasource.DataSource {
+	return &ServerlessRevisionsDataSource{}
+}
+
+func (d *ServerlessRevisionsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_serverless_revisions"
+}
+
+func (d *ServerlessRevisionsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the revisions (past deployments) of a serverless container, newest first.",
+		Attributes: map[string]schema.Attribute{
+			"container_id": schema.StringAttribute{
+				Description: "ID of the serverless container.",
+				Required:    true,
+			},
+			"revisions": schema.ListNestedAttribute{
+				Description: "List of revisions, newest first.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "Unique identifier for the revision.",
+							Computed:    true,
+						},
+						"number": schema.Int64Attribute{
+							Description: "Revision number, as used by traffic and pinned_revision.",
+							Computed:    true,
+						},
+						"image": schema.StringAttribute{
+							Description: "Container image the revision runs.",
+							Computed:    true,
+						},
+						"image_tag": schema.StringAttribute{
+							Description: "Image tag the revision runs.",
+							Computed:    true,
+						},
+						"source_sha256": schema.StringAttribute{
+							Description: "SHA-256 of the uploaded source archive (zip_upload deployments).",
+							Computed:    true,
+						},
+						"environment_hash": schema.StringAttribute{
+							Description: "Hash of the revision's environment variables and secrets.",
+							Computed:    true,
+						},
+						"status": schema.StringAttribute{
+							Description: "Revision status (deploying, ready, failed, retired).",
+							Computed:    true,
+						},
+						"traffic_percent": schema.Int64Attribute{
+							Description: "Share of requests the revision currently receives.",
+							Computed:    true,
+						},
+						"created_at": schema.StringAttribute{
+							Description: "Timestamp when the revision was deployed.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ServerlessRevisionsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = c
+}
+
+func (d *ServerlessRevisionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if d.client == nil {
+		resp.Diagnostics.AddError(
+			"Unconfigured Client",
+			"Expected configured client. Please report this issue to the provider developers.",
+		)
+		return
+	}
+
+	var data ServerlessRevisionsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	revisions, err := d.client.ListServerlessRevisions(ctx, data.ContainerID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list serverless revisions", err.Error())
+		return
+	}
+
+	data.Revisions = make([]ServerlessRevisionModel, len(revisions))
+	for i, rev := range revisions {
+		data.Revisions[i] = ServerlessRevisionModel{
+			ID:              types.StringValue(rev.ID),
+			Number:          types.Int64Value(int64(rev.Number)),
+			ImageTag:        types.StringValue(rev.ImageTag),
+			EnvironmentHash: types.StringValue(rev.EnvironmentHash),
+			Status:          types.StringValue(rev.Status),
+			TrafficPercent:  types.Int64Value(int64(rev.TrafficPercent)),
+			CreatedAt:       types.StringValue(rev.CreatedAt),
+		}
+
+		if rev.Image != nil {
+			data.Revisions[i].Image = types.StringValue(*rev.Image)
+		} else {
+			data.Revisions[i].Image = types.StringNull()
+		}
+
+		if rev.SourceSHA256 != nil {
+			data.Revisions[i].SourceSHA256 = types.StringValue(*rev.SourceSHA256)
+		} else {
+			data.Revisions[i].SourceSHA256 = types.StringNull()
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,181 @@
+// Package functions implements the provider-defined functions of the
+// DanubeData provider.
+package functions
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/AdrianSilaghi/terraform-provider-danubedata/internal/connstring"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &ConnectionStringFunction{}
+
+// ConnectionStringFunction builds a connection string for a database or
+// cache instance in a chosen format.
+type ConnectionStringFunction struct{}
+
+func NewConnectionStringFunction() function.Function {
+	return &ConnectionStringFunction{}
+}
+
+func (f *ConnectionStringFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "connection_string"
+}
+
+func (f *ConnectionStringFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Builds a connection string for a database or cache instance.",
+		Description: "Builds a connection string for a danubedata_database or danubedata_cache in the given format. " +
+			"The instance may be the resource itself, an element of the danubedata_databases or danubedata_caches data sources, " +
+			"or any object with the same attributes, e.g. read from another configuration's outputs. " +
+			"Formats: uri, jdbc and go for all databases, libpq for PostgreSQL, and uri and rediss for caches.",
+		Parameters: []function.Parameter{
+			function.DynamicParameter{
+				Name: "instance",
+				Description: "Object with the connection attributes of the instance: engine or cache_provider, host or endpoint, port, " +
+					"and optionally username, password, database or database_name, and tls_mode.",
+			},
+			function.StringParameter{
+				Name:        "format",
+				Description: "Connection string format: uri, libpq, jdbc, go or rediss.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *ConnectionStringFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var instance types.Dynamic
+	var format string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &instance, &format))
+	if resp.Error != nil {
+		return
+	}
+
+	obj, ok := instance.UnderlyingValue().(types.Object)
+	if !ok || obj.IsNull() {
+		resp.Error = function.NewArgumentFuncError(0, "instance must be an object, such as a danubedata_database or danubedata_cache resource")
+		return
+	}
+
+	details, known, err := connectionDetails(obj.Attributes())
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+	if !known {
+		resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, types.StringUnknown()))
+		return
+	}
+
+	s, err := connstring.Build(details, format)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, s))
+}
+
+// connectionDetails reads the connection attributes of an instance object. It
+// reports known = false when any of them is not known yet, e.g. during the
+// plan that creates the instance.
+func connectionDetails(attrs map[string]attr.Value) (connstring.Details, bool, error) {
+	var d connstring.Details
+	known := true
+
+	str := func(names ...string) (string, error) {
+		for _, name := range names {
+			v, ok := attrs[name]
+			if !ok || v.IsNull() {
+				continue
+			}
+			if v.IsUnknown() {
+				known = false
+				return "", nil
+			}
+			s, ok := v.(types.String)
+			if !ok {
+				return "", fmt.Errorf("attribute %q must be a string", name)
+			}
+			return s.ValueString(), nil
+		}
+		return "", nil
+	}
+
+	var err error
+	if d.Engine, err = str("engine", "cache_provider"); err != nil {
+		return d, false, err
+	}
+	if d.Host, err = str("host", "endpoint"); err != nil {
+		return d, false, err
+	}
+	if d.Username, err = str("username"); err != nil {
+		return d, false, err
+	}
+	if d.Password, err = str("password"); err != nil {
+		return d, false, err
+	}
+	if d.Database, err = str("database", "database_name"); err != nil {
+		return d, false, err
+	}
+	if d.TLSMode, err = str("tls_mode"); err != nil {
+		return d, false, err
+	}
+
+	port, ok := attrs["port"]
+	switch {
+	case !ok || port.IsNull():
+		return d, false, fmt.Errorf("attribute \"port\" is required")
+	case port.IsUnknown():
+		known = false
+	default:
+		n, err := portNumber(port)
+		if err != nil {
+			return d, false, err
+		}
+		d.Port = n
+	}
+
+	if !known {
+		return d, false, nil
+	}
+	if d.Engine == "" {
+		return d, false, fmt.Errorf("attribute \"engine\" or \"cache_provider\" is required")
+	}
+	if d.Host == "" {
+		return d, false, fmt.Errorf("attribute \"host\" or \"endpoint\" is required")
+	}
+	d.Engine = strings.ToLower(d.Engine)
+	return d, true, nil
+}
+
+// portNumber converts the port attribute, which is a number in resource
+// objects and may be a string in values read from outputs or JSON.
+func portNumber(v attr.Value) (int, error) {
+	switch p := v.(type) {
+	case types.Int64:
+		return int(p.ValueInt64()), nil
+	case types.Number:
+		n, accuracy := p.ValueBigFloat().Int64()
+		if accuracy != big.Exact {
+			return 0, fmt.Errorf("attribute \"port\" must be a whole number")
+		}
+		return int(n), nil
+	case types.String:
+		n, err := strconv.Atoi(p.ValueString())
+		if err != nil {
+			return 0, fmt.Errorf("attribute \"port\" must be a number, got %q", p.ValueString())
+		}
+		return n, nil
+	}
+	return 0, fmt.Errorf("attribute \"port\" must be a number")
+}
@@ -0,0 +1,123 @@
+package functions
+
+import (
+	"context"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func runConnectionString(t *testing.T, instance map[string]attr.Value, format string) (types.String, *function.FuncError) {
+	t.Helper()
+
+	attrTypes := make(map[string]attr.Type, len(instance))
+	for name, v := range instance {
+		attrTypes[name] = v.Type(context.Background())
+	}
+	obj := types.ObjectValueMust(attrTypes, instance)
+
+	req := function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{types.DynamicValue(obj), types.StringValue(format)}),
+	}
+	resp := &function.RunResponse{Result: function.NewResultData(types.StringUnknown())}
+	(&ConnectionStringFunction{}).Run(context.Background(), req, resp)
+
+	result, _ := resp.Result.Value().(types.String)
+	return result, resp.Error
+}
+
+func TestConnectionStringFunction_Database(t *testing.T) {
+	database := map[string]attr.Value{
+		"engine":   types.StringValue("postgresql"),
+		"host":     types.StringValue("db-123.postgresql.cluster.local"),
+		"endpoint": types.StringValue("db-123.postgresql.cluster.local"),
+		"port":     types.NumberValue(big.NewFloat(5432)),
+		"username": types.StringValue("admin"),
+		"password": types.StringValue("s3cret"),
+		"database": types.StringValue("app"),
+		"tls_mode": types.StringValue("require"),
+	}
+
+	got, err := runConnectionString(t, database, "libpq")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := "host=db-123.postgresql.cluster.local port=5432 user=admin password=s3cret dbname=app sslmode=require"
+	if got.ValueString() != want {
+		t.Errorf("result = %q, want %q", got.ValueString(), want)
+	}
+}
+
+func TestConnectionStringFunction_CacheFromListDataSource(t *testing.T) {
+	cache := map[string]attr.Value{
+		"cache_provider": types.StringValue("valkey"),
+		"endpoint":       types.StringValue("cache-1.valkey.cluster.local"),
+		"port":           types.Int64Value(6379),
+	}
+
+	got, err := runConnectionString(t, cache, "uri")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got.ValueString() != "redis://cache-1.valkey.cluster.local:6379" {
+		t.Errorf("result = %q, want redis://cache-1.valkey.cluster.local:6379", got.ValueString())
+	}
+}
+
+func TestConnectionStringFunction_UnknownAttribute(t *testing.T) {
+	database := map[string]attr.Value{
+		"engine":   types.StringValue("mysql"),
+		"host":     types.StringUnknown(),
+		"port":     types.Int64Unknown(),
+		"password": types.StringUnknown(),
+	}
+
+	got, err := runConnectionString(t, database, "jdbc")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !got.IsUnknown() {
+		t.Errorf("result = %v, want unknown", got)
+	}
+}
+
+func TestConnectionStringFunction_Errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		instance map[string]attr.Value
+		format   string
+		wantErr  string
+	}{
+		{
+			name:     "format not available",
+			instance: map[string]attr.Value{"engine": types.StringValue("mysql"), "host": types.StringValue("db"), "port": types.Int64Value(3306)},
+			format:   "libpq",
+			wantErr:  "available formats: go, jdbc, uri",
+		},
+		{
+			name:     "missing engine",
+			instance: map[string]attr.Value{"host": types.StringValue("db"), "port": types.Int64Value(3306)},
+			format:   "uri",
+			wantErr:  `"engine" or "cache_provider" is required`,
+		},
+		{
+			name:     "missing port",
+			instance: map[string]attr.Value{"engine": types.StringValue("mysql"), "host": types.StringValue("db")},
+			format:   "uri",
+			wantErr:  `"port" is required`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := runConnectionString(t, tt.instance, tt.format)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("error = %v, want it to contain %q", err, tt.wantErr)
+			}
+		})
+	}
+}
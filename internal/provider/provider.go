@@ -6,8 +6,10 @@ import (
 
 	"github.com/AdrianSilaghi/terraform-provider-danubedata/internal/client"
 	"github.com/AdrianSilaghi/terraform-provider-danubedata/internal/datasources"
+	"github.com/AdrianSilaghi/terraform-provider-danubedata/internal/functions"
 	"github.com/AdrianSilaghi/terraform-provider-danubedata/internal/resources"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -16,6 +18,7 @@ import (
 )
 
 var _ provider.Provider = &DanubeDataProvider{}
+var _ provider.ProviderWithFunctions = &DanubeDataProvider{}
 
 type DanubeDataProvider struct {
 	version string
@@ -103,11 +106,16 @@ func (p *DanubeDataProvider) Resources(ctx context.Context) []func() resource.Re
 		// Compute
 		resources.NewVpsResource,
 		resources.NewServerlessResource,
+		resources.NewServerlessDomainResource,
+		resources.NewServerlessJobResource,
 
 		// Data Services
 		resources.NewCacheResource,
 		resources.NewDatabaseResource,
 		resources.NewDatabaseReplicaResource,
+		resources.NewDatabaseReplicaPromotionResource,
+		resources.NewDatabaseUserResource,
+		resources.NewDatabaseSchemaResource,
 		resources.NewParameterGroupResource,
 
 		// Storage
@@ -117,6 +125,7 @@ func (p *DanubeDataProvider) Resources(ctx context.Context) []func() resource.Re
 		// Security
 		resources.NewSshKeyResource,
 		resources.NewFirewallResource,
+		resources.NewIPSetResource,
 
 		// Snapshots
 		resources.NewVpsSnapshotResource,
@@ -126,6 +135,12 @@ func (p *DanubeDataProvider) Resources(ctx context.Context) []func() resource.Re
 		// Static sites
 		resources.NewStaticSiteResource,
 		resources.NewStaticSiteDomainResource,
+		resources.NewStaticSitePrimaryDomainResource,
+		resources.NewStaticSiteDeploymentResource,
+
+		// DNS
+		resources.NewDNSZoneResource,
+		resources.NewDNSRecordResource,
 	}
 }
 
@@ -144,11 +159,24 @@ func (p *DanubeDataProvider) DataSources(ctx context.Context) []func() datasourc
 		datasources.NewCachesDataSource,
 		datasources.NewFirewallsDataSource,
 		datasources.NewServerlessContainersDataSource,
+		datasources.NewServerlessRevisionsDataSource,
+		datasources.NewServerlessLogsDataSource,
 		datasources.NewStorageBucketsDataSource,
 		datasources.NewStorageAccessKeysDataSource,
 		datasources.NewVpsSnapshotsDataSource,
 		datasources.NewCacheSnapshotsDataSource,
 		datasources.NewDatabaseSnapshotsDataSource,
+		datasources.NewDatabaseBackupsDataSource,
+		datasources.NewDatabaseReplicasDataSource,
 		datasources.NewStaticSitesDataSource,
+
+		// Lookup data sources
+		datasources.NewDNSZoneDataSource,
+	}
+}
+
+func (p *DanubeDataProvider) Functions(ctx context.Context) []func() function.Function {
+	return []func() function.Function{
+		functions.NewConnectionStringFunction,
 	}
 }
@@ -26,11 +26,12 @@ func TestProvider_HasExpectedResourceTypes(t *testing.T) {
 	resources := p.Resources(context.Background())
 
 	// Verify we have the expected number of resources:
-	// vps, serverless, cache, database, database_replica, parameter_group,
-	// storage_bucket, storage_access_key, ssh_key, firewall,
+	// vps, serverless, serverless_domain, serverless_job, cache, database,
+	// database_replica, database_replica_promotion, database_user, database_schema, parameter_group,
+	// storage_bucket, storage_access_key, ssh_key, firewall, ip_set,
 	// vps_snapshot, cache_snapshot, database_snapshot,
-	// static_site, static_site_domain
-	expectedResourceCount := 15
+	// static_site, static_site_domain, static_site_primary_domain, static_site_deployment, dns_zone, dns_record
+	expectedResourceCount := 25
 	if len(resources) != expectedResourceCount {
 		t.Errorf("expected %d resources, got %d", expectedResourceCount, len(resources))
 	}
@@ -45,10 +46,26 @@ func TestProvider_HasExpectedDataSourceTypes(t *testing.T) {
 	// Verify we have the expected number of data sources:
 	// Listing: vps_images, cache_providers, database_providers, ssh_keys, parameter_groups (5)
 	// Resource listings: vpss, databases, caches, firewalls, serverless_containers,
-	//   storage_buckets, storage_access_keys, vps_snapshots, cache_snapshots,
-	//   database_snapshots, static_sites (11)
-	expectedDataSourceCount := 16
+	//   serverless_revisions, serverless_logs, storage_buckets, storage_access_keys,
+	//   vps_snapshots, cache_snapshots, database_snapshots, database_backups,
+	//   database_replicas, static_sites (15)
+	// Lookup: dns_zone (1)
+	expectedDataSourceCount := 21
 	if len(dataSources) != expectedDataSourceCount {
 		t.Errorf("expected %d data sources, got %d", expectedDataSourceCount, len(dataSources))
 	}
 }
+
+// TestProvider_HasExpectedFunctions verifies the provider registers expected functions
+func TestProvider_HasExpectedFunctions(t *testing.T) {
+	p, ok := New("test")().(provider.ProviderWithFunctions)
+	if !ok {
+		t.Fatal("provider does not implement ProviderWithFunctions")
+	}
+
+	// connection_string
+	expectedFunctionCount := 1
+	if functions := p.Functions(context.Background()); len(functions) != expectedFunctionCount {
+		t.Errorf("expected %d functions, got %d", expectedFunctionCount, len(functions))
+	}
+}
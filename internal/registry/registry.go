@@ -0,0 +1,290 @@
+// Package registry resolves container image tags to content digests using the
+// OCI distribution API, so a moved tag can be detected at plan time.
+package registry
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	dockerHubHost     = "registry-1.docker.io"
+	dockerHubLibrary  = "library/"
+	maxManifestLength = 4 << 20
+)
+
+// manifestMediaTypes are accepted when resolving a tag. An index or manifest
+// list is preferred so that multi-arch images resolve to the same digest the
+// platform pulls.
+var manifestMediaTypes = []string{
+	"application/vnd.oci.image.index.v1+json",
+	"application/vnd.docker.distribution.manifest.list.v2+json",
+	"application/vnd.oci.image.manifest.v1+json",
+	"application/vnd.docker.distribution.manifest.v2+json",
+}
+
+// Reference is a parsed image reference.
+type Reference struct {
+	Host       string
+	Repository string
+	Tag        string
+}
+
+// String returns the reference in host/repository:tag form.
+func (r Reference) String() string {
+	return fmt.Sprintf("%s/%s:%s", r.Host, r.Repository, r.Tag)
+}
+
+// ParseReference splits an image (without tag) and a tag into a Reference.
+// Images without a registry host resolve to Docker Hub, and single-segment
+// Docker Hub images to its library namespace, as `docker pull` does.
+func ParseReference(image, tag string) (Reference, error) {
+	if image == "" {
+		return Reference{}, fmt.Errorf("image is empty")
+	}
+	if strings.ContainsAny(image, "@") {
+		return Reference{}, fmt.Errorf("image %q must not contain a digest", image)
+	}
+	if tag == "" {
+		tag = "latest"
+	}
+
+	ref := Reference{Host: dockerHubHost, Repository: image, Tag: tag}
+	if first, rest, ok := strings.Cut(image, "/"); ok && (strings.ContainsAny(first, ".:") || first == "localhost") {
+		ref.Host = first
+		ref.Repository = rest
+	}
+	if ref.Host == "docker.io" || ref.Host == "index.docker.io" {
+		ref.Host = dockerHubHost
+	}
+	if ref.Host == dockerHubHost && !strings.Contains(ref.Repository, "/") {
+		ref.Repository = dockerHubLibrary + ref.Repository
+	}
+	if ref.Repository == "" {
+		return Reference{}, fmt.Errorf("image %q has no repository", image)
+	}
+	return ref, nil
+}
+
+// Credentials authenticate against a registry. Both empty means anonymous.
+type Credentials struct {
+	Username string
+	Password string
+}
+
+// Resolver resolves image tags to digests.
+type Resolver struct {
+	httpClient *http.Client
+
+	// plainHTTP reports whether a registry host is contacted over plain HTTP.
+	plainHTTP func(host string) bool
+}
+
+// NewResolver returns a Resolver that uses HTTPS for every registry except
+// localhost and loopback addresses, which are commonly run without TLS.
+func NewResolver() *Resolver {
+	return &Resolver{
+		httpClient: &http.Client{Timeout: 30 * time.Second},
+		plainHTTP:  isLoopbackHost,
+	}
+}
+
+func isLoopbackHost(host string) bool {
+	name := strings.Trim(host, "[]")
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		name = h
+	}
+	return name == "localhost" || net.ParseIP(name).IsLoopback()
+}
+
+// ResolveDigest returns the content digest (sha256:...) the tag currently
+// points to. It answers registry auth challenges with creds, using HTTP basic
+// auth or a bearer token from the registry's token service.
+func (r *Resolver) ResolveDigest(ctx context.Context, ref Reference, creds Credentials) (string, error) {
+	scheme := "https"
+	if r.plainHTTP(ref.Host) {
+		scheme = "http"
+	}
+	manifestURL := fmt.Sprintf("%s://%s/v2/%s/manifests/%s", scheme, ref.Host, ref.Repository, url.PathEscape(ref.Tag))
+
+	resp, err := r.getManifest(ctx, manifestURL, "")
+	if err != nil {
+		return "", err
+	}
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		challenge := resp.Header.Get("WWW-Authenticate")
+		resp.Body.Close()
+
+		authorization, err := r.authorize(ctx, challenge, ref, creds)
+		if err != nil {
+			return "", err
+		}
+		resp, err = r.getManifest(ctx, manifestURL, authorization)
+		if err != nil {
+			return "", err
+		}
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return "", fmt.Errorf("registry denied access to %s (HTTP %d); check the registry credentials", ref, resp.StatusCode)
+	case http.StatusNotFound:
+		return "", fmt.Errorf("image %s not found in registry", ref)
+	default:
+		return "", fmt.Errorf("registry returned HTTP %d for %s", resp.StatusCode, ref)
+	}
+
+	if digest := resp.Header.Get("Docker-Content-Digest"); digest != "" {
+		return digest, nil
+	}
+
+	// Registries are not required to send the header; the digest is then
+	// the hash of the manifest exactly as served.
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxManifestLength))
+	if err != nil {
+		return "", fmt.Errorf("failed to read manifest of %s: %w", ref, err)
+	}
+	sum := sha256.Sum256(body)
+	return "sha256:" + hex.EncodeToString(sum[:]), nil
+}
+
+func (r *Resolver) getManifest(ctx context.Context, manifestURL, authorization string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, manifestURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create registry request: %w", err)
+	}
+	req.Header.Set("Accept", strings.Join(manifestMediaTypes, ", "))
+	if authorization != "" {
+		req.Header.Set("Authorization", authorization)
+	}
+
+	resp, err := r.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to contact registry: %w", err)
+	}
+	return resp, nil
+}
+
+// authorize answers a WWW-Authenticate challenge and returns the value of the
+// Authorization header to retry with.
+func (r *Resolver) authorize(ctx context.Context, challenge string, ref Reference, creds Credentials) (string, error) {
+	scheme, params := parseChallenge(challenge)
+	switch strings.ToLower(scheme) {
+	case "basic":
+		if creds.Username == "" && creds.Password == "" {
+			return "", fmt.Errorf("registry %s requires credentials", ref.Host)
+		}
+		return "Basic " + base64.StdEncoding.EncodeToString([]byte(creds.Username+":"+creds.Password)), nil
+	case "bearer":
+		token, err := r.fetchToken(ctx, params, ref, creds)
+		if err != nil {
+			return "", err
+		}
+		return "Bearer " + token, nil
+	default:
+		return "", fmt.Errorf("registry %s sent an unsupported auth challenge %q", ref.Host, challenge)
+	}
+}
+
+type tokenResponse struct {
+	Token       string `json:"token"`
+	AccessToken string `json:"access_token"`
+}
+
+// fetchToken obtains a pull token from the registry's token service, as
+// described by the Docker registry token authentication specification.
+func (r *Resolver) fetchToken(ctx context.Context, params map[string]string, ref Reference, creds Credentials) (string, error) {
+	realm := params["realm"]
+	if realm == "" {
+		return "", fmt.Errorf("registry %s sent a bearer challenge without a realm", ref.Host)
+	}
+
+	tokenURL, err := url.Parse(realm)
+	if err != nil {
+		return "", fmt.Errorf("registry %s sent an invalid token realm %q: %w", ref.Host, realm, err)
+	}
+	query := tokenURL.Query()
+	if service := params["service"]; service != "" {
+		query.Set("service", service)
+	}
+	scope := params["scope"]
+	if scope == "" {
+		scope = fmt.Sprintf("repository:%s:pull", ref.Repository)
+	}
+	query.Set("scope", scope)
+	tokenURL.RawQuery = query.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, tokenURL.String(), nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to create token request: %w", err)
+	}
+	if creds.Username != "" || creds.Password != "" {
+		req.SetBasicAuth(creds.Username, creds.Password)
+	}
+
+	resp, err := r.httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to contact token service of %s: %w", ref.Host, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("token service of %s returned HTTP %d; check the registry credentials", ref.Host, resp.StatusCode)
+	}
+
+	var token tokenResponse
+	if err := json.NewDecoder(resp.Body).Decode(&token); err != nil {
+		return "", fmt.Errorf("failed to decode token from %s: %w", ref.Host, err)
+	}
+	if token.Token != "" {
+		return token.Token, nil
+	}
+	if token.AccessToken != "" {
+		return token.AccessToken, nil
+	}
+	return "", fmt.Errorf("token service of %s returned no token", ref.Host)
+}
+
+// parseChallenge splits a WWW-Authenticate header such as
+// `Bearer realm="https://auth.example.com/token",service="registry"` into its
+// scheme and parameters.
+func parseChallenge(header string) (string, map[string]string) {
+	scheme, rest, _ := strings.Cut(strings.TrimSpace(header), " ")
+	params := map[string]string{}
+
+	for rest = strings.TrimSpace(rest); rest != ""; rest = strings.TrimSpace(rest) {
+		key, value, ok := strings.Cut(rest, "=")
+		if !ok {
+			break
+		}
+		key = strings.ToLower(strings.TrimSpace(key))
+
+		if strings.HasPrefix(value, `"`) {
+			end := strings.Index(value[1:], `"`)
+			if end < 0 {
+				params[key] = value[1:]
+				break
+			}
+			params[key] = value[1 : end+1]
+			rest = strings.TrimPrefix(strings.TrimSpace(value[end+2:]), ",")
+			continue
+		}
+
+		value, rest, _ = strings.Cut(value, ",")
+		params[key] = strings.TrimSpace(value)
+	}
+	return scheme, params
+}
@@ -0,0 +1,210 @@
+package registry
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+const testDigest = "sha256:6c3c624b58dbbcd3c0dd82b4c53f04194d1247c6eebdaab7c610cf7d66709b3b"
+
+// newTestRegistry starts a registry stand-in serving one manifest for
+// team/app:v1. auth is "", "basic" or "bearer".
+func newTestRegistry(t *testing.T, auth string, sendDigest bool) (*httptest.Server, Reference) {
+	t.Helper()
+
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/token" {
+			if user, pass, ok := r.BasicAuth(); !ok || user != "robot" || pass != "s3cret" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			if r.URL.Query().Get("scope") != "repository:team/app:pull" {
+				t.Errorf("scope = %v, want repository:team/app:pull", r.URL.Query().Get("scope"))
+			}
+			if r.URL.Query().Get("service") != "test-registry" {
+				t.Errorf("service = %v, want test-registry", r.URL.Query().Get("service"))
+			}
+			_ = json.NewEncoder(w).Encode(tokenResponse{Token: "pull-token"})
+			return
+		}
+
+		switch auth {
+		case "basic":
+			if user, pass, ok := r.BasicAuth(); !ok || user != "robot" || pass != "s3cret" {
+				w.Header().Set("WWW-Authenticate", `Basic realm="test-registry"`)
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+		case "bearer":
+			if r.Header.Get("Authorization") != "Bearer pull-token" {
+				w.Header().Set("WWW-Authenticate", `Bearer realm="`+server.URL+`/token",service="test-registry",scope="repository:team/app:pull"`)
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+		}
+
+		if !strings.Contains(r.Header.Get("Accept"), "application/vnd.oci.image.index.v1+json") {
+			t.Errorf("Accept = %v, want OCI index media type", r.Header.Get("Accept"))
+		}
+		if r.URL.Path != "/v2/team/app/manifests/v1" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/vnd.oci.image.index.v1+json")
+		if sendDigest {
+			w.Header().Set("Docker-Content-Digest", testDigest)
+		}
+		_, _ = w.Write([]byte(`{"schemaVersion":2,"manifests":[]}`))
+	}))
+	t.Cleanup(server.Close)
+
+	ref, err := ParseReference(strings.TrimPrefix(server.URL, "http://")+"/team/app", "v1")
+	if err != nil {
+		t.Fatalf("ParseReference: %v", err)
+	}
+	return server, ref
+}
+
+func TestResolveDigest_Anonymous(t *testing.T) {
+	_, ref := newTestRegistry(t, "", true)
+
+	digest, err := NewResolver().ResolveDigest(context.Background(), ref, Credentials{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if digest != testDigest {
+		t.Errorf("digest = %v, want %v", digest, testDigest)
+	}
+}
+
+func TestResolveDigest_BasicAuth(t *testing.T) {
+	_, ref := newTestRegistry(t, "basic", true)
+
+	digest, err := NewResolver().ResolveDigest(context.Background(), ref, Credentials{Username: "robot", Password: "s3cret"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if digest != testDigest {
+		t.Errorf("digest = %v, want %v", digest, testDigest)
+	}
+
+	if _, err := NewResolver().ResolveDigest(context.Background(), ref, Credentials{}); err == nil || !strings.Contains(err.Error(), "requires credentials") {
+		t.Errorf("error = %v, want missing credentials", err)
+	}
+}
+
+func TestResolveDigest_BearerToken(t *testing.T) {
+	_, ref := newTestRegistry(t, "bearer", true)
+
+	digest, err := NewResolver().ResolveDigest(context.Background(), ref, Credentials{Username: "robot", Password: "s3cret"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if digest != testDigest {
+		t.Errorf("digest = %v, want %v", digest, testDigest)
+	}
+
+	_, err = NewResolver().ResolveDigest(context.Background(), ref, Credentials{Username: "robot", Password: "wrong"})
+	if err == nil || !strings.Contains(err.Error(), "HTTP 401") {
+		t.Errorf("error = %v, want token service rejection", err)
+	}
+}
+
+func TestResolveDigest_HashesManifestWithoutDigestHeader(t *testing.T) {
+	_, ref := newTestRegistry(t, "", false)
+
+	digest, err := NewResolver().ResolveDigest(context.Background(), ref, Credentials{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	sum := sha256.Sum256([]byte(`{"schemaVersion":2,"manifests":[]}`))
+	if want := "sha256:" + hex.EncodeToString(sum[:]); digest != want {
+		t.Errorf("digest = %v, want %v", digest, want)
+	}
+}
+
+func TestResolveDigest_NotFound(t *testing.T) {
+	_, ref := newTestRegistry(t, "", true)
+	ref.Tag = "missing"
+
+	_, err := NewResolver().ResolveDigest(context.Background(), ref, Credentials{})
+	if err == nil || !strings.Contains(err.Error(), "not found") {
+		t.Errorf("error = %v, want not found", err)
+	}
+}
+
+func TestParseReference(t *testing.T) {
+	tests := []struct {
+		image, tag string
+		want       Reference
+	}{
+		{"nginx", "", Reference{Host: "registry-1.docker.io", Repository: "library/nginx", Tag: "latest"}},
+		{"bitnami/redis", "7.4", Reference{Host: "registry-1.docker.io", Repository: "bitnami/redis", Tag: "7.4"}},
+		{"docker.io/library/nginx", "1.27", Reference{Host: "registry-1.docker.io", Repository: "library/nginx", Tag: "1.27"}},
+		{"ghcr.io/example/api", "v1", Reference{Host: "ghcr.io", Repository: "example/api", Tag: "v1"}},
+		{"localhost:5000/app", "dev", Reference{Host: "localhost:5000", Repository: "app", Tag: "dev"}},
+		{"localhost/app", "dev", Reference{Host: "localhost", Repository: "app", Tag: "dev"}},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseReference(tt.image, tt.tag)
+		if err != nil {
+			t.Errorf("ParseReference(%q, %q) error: %v", tt.image, tt.tag, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ParseReference(%q, %q) = %+v, want %+v", tt.image, tt.tag, got, tt.want)
+		}
+	}
+
+	for _, image := range []string{"", "nginx@sha256:abc"} {
+		if _, err := ParseReference(image, "latest"); err == nil {
+			t.Errorf("ParseReference(%q) expected an error", image)
+		}
+	}
+}
+
+func TestParseChallenge(t *testing.T) {
+	scheme, params := parseChallenge(`Bearer realm="https://auth.example.com/token",service="registry.example.com",scope="repository:a/b:pull,push"`)
+	if scheme != "Bearer" {
+		t.Errorf("scheme = %v, want Bearer", scheme)
+	}
+	want := map[string]string{
+		"realm":   "https://auth.example.com/token",
+		"service": "registry.example.com",
+		"scope":   "repository:a/b:pull,push",
+	}
+	for k, v := range want {
+		if params[k] != v {
+			t.Errorf("params[%s] = %q, want %q", k, params[k], v)
+		}
+	}
+
+	scheme, params = parseChallenge(`Basic realm=registry`)
+	if scheme != "Basic" || params["realm"] != "registry" {
+		t.Errorf("parseChallenge(Basic) = %v %v", scheme, params)
+	}
+}
+
+func TestIsLoopbackHost(t *testing.T) {
+	for host, want := range map[string]bool{
+		"localhost":       true,
+		"localhost:5000":  true,
+		"127.0.0.1:35411": true,
+		"[::1]:5000":      true,
+		"ghcr.io":         false,
+		"10.0.0.5:5000":   false,
+	} {
+		if got := isLoopbackHost(host); got != want {
+			t.Errorf("isLoopbackHost(%q) = %v, want %v", host, got, want)
+		}
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/AdrianSilaghi/terraform-provider-danubedata/internal/client"
+	"github.com/AdrianSilaghi/terraform-provider-danubedata/internal/connstring"
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -25,6 +26,7 @@ var (
 	_ resource.Resource                = &CacheResource{}
 	_ resource.ResourceWithConfigure   = &CacheResource{}
 	_ resource.ResourceWithImportState = &CacheResource{}
+	_ resource.ResourceWithModifyPlan  = &CacheResource{}
 )
 
 type CacheResource struct {
@@ -32,27 +34,36 @@ type CacheResource struct {
 }
 
 type CacheResourceModel struct {
-	ID               types.String   `tfsdk:"id"`
-	Name             types.String   `tfsdk:"name"`
-	Status           types.String   `tfsdk:"status"`
-	CacheProvider    types.String   `tfsdk:"cache_provider"`
-	ResourceProfile  types.String   `tfsdk:"resource_profile"`
-	MemorySizeMB     types.Int64    `tfsdk:"memory_size_mb"`
-	CPUCores         types.Int64    `tfsdk:"cpu_cores"`
-	Version          types.String   `tfsdk:"version"`
-	Datacenter       types.String   `tfsdk:"datacenter"`
-	ParameterGroupID types.String   `tfsdk:"parameter_group_id"`
-	Endpoint         types.String   `tfsdk:"endpoint"`
-	Port             types.Int64    `tfsdk:"port"`
-	ConnectionInfo   types.String   `tfsdk:"connection_info"`
-	Password         types.String   `tfsdk:"password"`
-	DnsEnabled       types.Bool     `tfsdk:"dns_enabled"`
-	MonthlyCostCents types.Int64    `tfsdk:"monthly_cost_cents"`
-	MonthlyCost      types.Float64  `tfsdk:"monthly_cost"`
-	DeployedAt       types.String   `tfsdk:"deployed_at"`
-	CreatedAt        types.String   `tfsdk:"created_at"`
-	UpdatedAt        types.String   `tfsdk:"updated_at"`
-	Timeouts         timeouts.Value `tfsdk:"timeouts"`
+	ID                types.String   `tfsdk:"id"`
+	Name              types.String   `tfsdk:"name"`
+	Status            types.String   `tfsdk:"status"`
+	CacheProvider     types.String   `tfsdk:"cache_provider"`
+	ResourceProfile   types.String   `tfsdk:"resource_profile"`
+	MemorySizeMB      types.Int64    `tfsdk:"memory_size_mb"`
+	CPUCores          types.Int64    `tfsdk:"cpu_cores"`
+	Version           types.String   `tfsdk:"version"`
+	Datacenter        types.String   `tfsdk:"datacenter"`
+	ParameterGroupID  types.String   `tfsdk:"parameter_group_id"`
+	Endpoint          types.String   `tfsdk:"endpoint"`
+	Host              types.String   `tfsdk:"host"`
+	Port              types.Int64    `tfsdk:"port"`
+	TLSMode           types.String   `tfsdk:"tls_mode"`
+	ConnectionInfo    types.String   `tfsdk:"connection_info"`
+	ConnectionStrings types.Map      `tfsdk:"connection_strings"`
+	Password          types.String   `tfsdk:"password"`
+	DnsEnabled        types.Bool     `tfsdk:"dns_enabled"`
+	PublicHostname    types.String   `tfsdk:"public_hostname"`
+	PasswordRotation  types.Object   `tfsdk:"password_rotation"`
+	PasswordRotatedAt types.String   `tfsdk:"password_rotated_at"`
+	MaintenanceWindow types.Object   `tfsdk:"maintenance_window"`
+	ApplyImmediately  types.Bool     `tfsdk:"apply_immediately"`
+	PendingChanges    types.Object   `tfsdk:"pending_changes"`
+	MonthlyCostCents  types.Int64    `tfsdk:"monthly_cost_cents"`
+	MonthlyCost       types.Float64  `tfsdk:"monthly_cost"`
+	DeployedAt        types.String   `tfsdk:"deployed_at"`
+	CreatedAt         types.String   `tfsdk:"created_at"`
+	UpdatedAt         types.String   `tfsdk:"updated_at"`
+	Timeouts          timeouts.Value `tfsdk:"timeouts"`
 }
 
 func NewCacheResource() resource.Resource {
@@ -134,26 +145,52 @@ func (r *CacheResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				Description: "Connection endpoint for the cache instance.",
 				Computed:    true,
 			},
+			"host": schema.StringAttribute{
+				Description: "Hostname to connect to. Same as endpoint.",
+				Computed:    true,
+			},
 			"port": schema.Int64Attribute{
 				Description: "Port number for the cache instance.",
 				Computed:    true,
 			},
+			"tls_mode": schema.StringAttribute{
+				Description: "TLS mode for connections to the instance: disable, require or verify-full. Null when the API does not report it.",
+				Computed:    true,
+			},
 			"connection_info": schema.StringAttribute{
 				Description: "Connection URI for the cache instance (e.g. redis://host:6379).",
 				Computed:    true,
 				Sensitive:   true,
 			},
+			"connection_strings": schema.MapAttribute{
+				Description: "Ready-to-use connection strings, keyed by format: uri (redis:// or, when TLS is required, rediss://) and rediss (a TLS URL, unless tls_mode is disable). Null until the password is available.",
+				Computed:    true,
+				Sensitive:   true,
+				ElementType: types.StringType,
+			},
 			"password": schema.StringAttribute{
-				Description: "Password for the cache instance.",
+				Description: "Password for the cache instance. Changes when rotated through password_rotation.",
 				Computed:    true,
 				Sensitive:   true,
 			},
 			"dns_enabled": schema.BoolAttribute{
-				Description: "Whether public DNS (and TCP LoadBalancer exposure) is enabled for the cache. Defaults to false. Note: the API does not currently return the live DNS state, so out-of-band changes will not be detected until the next apply that explicitly re-sets this field.",
+				Description: "Whether public DNS (and TCP LoadBalancer exposure) is enabled for the cache. Defaults to false. The live state is read on every refresh, so changes made outside Terraform show up as drift.",
 				Optional:    true,
 				Computed:    true,
 				Default:     booldefault.StaticBool(false),
 			},
+			"public_hostname": schema.StringAttribute{
+				Description: "Public DNS name of the cache while dns_enabled is true, e.g. for connecting from outside the platform. Null while public DNS is disabled.",
+				Computed:    true,
+			},
+			"password_rotation": passwordRotationAttribute("cache"),
+			"password_rotated_at": schema.StringAttribute{
+				Description: "Timestamp of the last password rotation through password_rotation. Null until the password is first rotated.",
+				Computed:    true,
+			},
+			"maintenance_window": maintenanceWindowAttribute("cache"),
+			"apply_immediately":  applyImmediatelyAttribute("cache"),
+			"pending_changes":    pendingChangesAttribute(),
 			"monthly_cost_cents": schema.Int64Attribute{
 				Description: "Monthly cost in cents.",
 				Computed:    true,
@@ -202,6 +239,34 @@ func (r *CacheResource) Configure(ctx context.Context, req resource.ConfigureReq
 	r.client = c
 }
 
+// ModifyPlan marks password, connection_info, connection_strings and
+// password_rotated_at as changing when password_rotation calls for a
+// rotation, so that a rotation that is only due because time has passed
+// still produces a plan.
+func (r *CacheResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var plan, state CacheResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	lastRotated := lastPasswordRotation(state.PasswordRotatedAt, state.CreatedAt)
+	if !passwordRotationDue(ctx, plan.PasswordRotation, state.PasswordRotation, lastRotated, time.Now(), &resp.Diagnostics) {
+		return
+	}
+
+	plan.Password = types.StringUnknown()
+	plan.ConnectionInfo = types.StringUnknown()
+	plan.ConnectionStrings = types.MapUnknown(types.StringType)
+	plan.PasswordRotatedAt = types.StringUnknown()
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
 func (r *CacheResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data CacheResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -236,6 +301,11 @@ func (r *CacheResource) Create(ctx context.Context, req resource.CreateRequest,
 		createReq.ParameterGroupID = &paramGroupID
 	}
 
+	createReq.MaintenanceWindow = expandMaintenanceWindow(ctx, data.MaintenanceWindow, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	tflog.Debug(ctx, "Creating cache instance", map[string]interface{}{
 		"name":           data.Name.ValueString(),
 		"cache_provider": data.CacheProvider.ValueString(),
@@ -272,8 +342,16 @@ func (r *CacheResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	// fetchCacheDns overwrites dns_enabled with the live value, which is false
+	// for a new cache, so keep the planned value for the toggle below.
+	plannedDnsEnabled := data.DnsEnabled.ValueBool()
+
 	r.mapCacheToState(cache, &data)
 	r.fetchCacheConnectionInfo(ctx, cache.ID, &data)
+	if err := r.fetchCacheDns(ctx, cache.ID, &data); err != nil {
+		addDnsStateWarning(&resp.Diagnostics, "cache", err)
+	}
+	data.PasswordRotatedAt = types.StringNull()
 
 	// Save state *before* attempting the DNS toggle so that a failing DNS call doesn't
 	// leave the user with a provisioned-but-untracked cache instance. A DNS error is then
@@ -283,11 +361,17 @@ func (r *CacheResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	if data.DnsEnabled.ValueBool() {
+	if plannedDnsEnabled {
 		if err := r.client.EnableCacheDns(ctx, cache.ID); err != nil {
 			resp.Diagnostics.AddError("Failed to enable cache DNS", err.Error())
 			return
 		}
+
+		data.DnsEnabled = types.BoolValue(true)
+		if err := r.fetchCacheDns(ctx, cache.ID, &data); err != nil {
+			addDnsStateWarning(&resp.Diagnostics, "cache", err)
+		}
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 	}
 }
 
@@ -309,7 +393,17 @@ func (r *CacheResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	}
 
 	r.mapCacheToState(cache, &data)
+
+	// apply_immediately only exists in configuration; default it after import.
+	if data.ApplyImmediately.IsNull() {
+		data.ApplyImmediately = types.BoolValue(true)
+	}
+
 	r.fetchCacheConnectionInfo(ctx, cache.ID, &data)
+	if err := r.fetchCacheDns(ctx, cache.ID, &data); err != nil {
+		resp.Diagnostics.AddError("Failed to read cache DNS state", err.Error())
+		return
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -359,6 +453,33 @@ func (r *CacheResource) Update(ctx context.Context, req resource.UpdateRequest,
 		hasChanges = true
 	}
 
+	// Changes still queued from an earlier apply are applied too once
+	// apply_immediately is switched on, or when they are changed again.
+	reconfigured := !data.ResourceProfile.Equal(state.ResourceProfile) || !data.ParameterGroupID.Equal(state.ParameterGroupID)
+	if applyQueuedChanges(data.ApplyImmediately, state.ApplyImmediately, state.PendingChanges, reconfigured) {
+		updateReq.ResourceProfile = data.ResourceProfile.ValueString()
+		if !data.ParameterGroupID.IsNull() {
+			paramGroupID := data.ParameterGroupID.ValueString()
+			updateReq.ParameterGroupID = &paramGroupID
+		}
+		hasChanges = true
+	}
+
+	if updateReq.ResourceProfile != "" || updateReq.ParameterGroupID != nil {
+		applyImmediately := data.ApplyImmediately.ValueBool()
+		updateReq.ApplyImmediately = &applyImmediately
+	}
+
+	if !data.MaintenanceWindow.Equal(state.MaintenanceWindow) {
+		if window := expandMaintenanceWindow(ctx, data.MaintenanceWindow, &resp.Diagnostics); window != nil {
+			updateReq.MaintenanceWindow = window
+			hasChanges = true
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if hasChanges {
 		tflog.Debug(ctx, "Updating cache instance", map[string]interface{}{
 			"id": data.ID.ValueString(),
@@ -411,6 +532,38 @@ func (r *CacheResource) Update(ctx context.Context, req resource.UpdateRequest,
 		r.fetchCacheConnectionInfo(ctx, cache.ID, &data)
 	}
 
+	data.PasswordRotatedAt = state.PasswordRotatedAt
+	lastRotated := lastPasswordRotation(state.PasswordRotatedAt, state.CreatedAt)
+	if passwordRotationDue(ctx, data.PasswordRotation, state.PasswordRotation, lastRotated, time.Now(), &resp.Diagnostics) {
+		gracePeriod := passwordRotationGracePeriod(ctx, data.PasswordRotation, &resp.Diagnostics)
+
+		tflog.Info(ctx, "Rotating cache password", map[string]interface{}{
+			"id":                   data.ID.ValueString(),
+			"grace_period_minutes": gracePeriod,
+		})
+
+		rotated, err := r.client.RotateCacheCredentials(ctx, data.ID.ValueString(), client.RotateCredentialsRequest{
+			GracePeriodMinutes: gracePeriod,
+		})
+		if err != nil {
+			// Save the applied changes with the current password and the
+			// prior password_rotation before reporting the failure, so the
+			// next apply retries the rotation.
+			data.PasswordRotation = state.PasswordRotation
+			if err := r.fetchCacheDns(ctx, data.ID.ValueString(), &data); err != nil {
+				addDnsStateWarning(&resp.Diagnostics, "cache", err)
+			}
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			resp.Diagnostics.AddError("Failed to rotate cache password", err.Error())
+			return
+		}
+		mapRotatedCacheCredentials(ctx, rotated, &data)
+	}
+
+	if err := r.fetchCacheDns(ctx, data.ID.ValueString(), &data); err != nil {
+		addDnsStateWarning(&resp.Diagnostics, "cache", err)
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -563,6 +716,8 @@ func (r *CacheResource) mapCacheToState(cache *client.CacheInstance, data *Cache
 	} else {
 		data.Endpoint = types.StringNull()
 	}
+	data.Host = data.Endpoint
+	data.TLSMode = stringOrNull(cache.TLSMode)
 
 	if cache.Port != nil && *cache.Port != 0 {
 		data.Port = types.Int64Value(int64(*cache.Port))
@@ -579,6 +734,20 @@ func (r *CacheResource) mapCacheToState(cache *client.CacheInstance, data *Cache
 	if cache.ParameterGroupID != nil && *cache.ParameterGroupID != "" {
 		data.ParameterGroupID = types.StringValue(*cache.ParameterGroupID)
 	}
+
+	data.MaintenanceWindow = flattenMaintenanceWindow(cache.MaintenanceWindow)
+	data.PendingChanges = flattenPendingChanges(cache.PendingChanges)
+
+	// Queued changes are shown as the values they change to, so plans do not
+	// keep proposing them until the maintenance window applies them.
+	if pending := cache.PendingChanges; pending != nil {
+		if pending.ResourceProfile != nil {
+			data.ResourceProfile = types.StringValue(*pending.ResourceProfile)
+		}
+		if pending.ParameterGroupID != nil {
+			data.ParameterGroupID = types.StringValue(*pending.ParameterGroupID)
+		}
+	}
 }
 
 // fetchCacheConnectionInfo populates connection_info and password from the API.
@@ -592,8 +761,61 @@ func (r *CacheResource) fetchCacheConnectionInfo(ctx context.Context, id string,
 		})
 		data.ConnectionInfo = types.StringNull()
 		data.Password = types.StringNull()
+		data.ConnectionStrings = types.MapNull(types.StringType)
 		return
 	}
 	data.ConnectionInfo = types.StringValue(info.ConnectionInfo)
 	data.Password = types.StringValue(info.Password)
+	data.ConnectionStrings = connectionStringsValue(ctx, cacheConnectionDetails(data))
+}
+
+// cacheConnectionDetails collects the connection attributes of a cache.
+func cacheConnectionDetails(data *CacheResourceModel) connstring.Details {
+	return connstring.Details{
+		Engine:   data.CacheProvider.ValueString(),
+		Host:     data.Host.ValueString(),
+		Port:     int(data.Port.ValueInt64()),
+		Password: data.Password.ValueString(),
+		TLSMode:  data.TLSMode.ValueString(),
+	}
+}
+
+// fetchCacheDns populates dns_enabled and public_hostname from the live DNS
+// state. An instance without the DNS endpoint is tolerated and keeps its
+// planned or prior dns_enabled; any other failure is returned.
+func (r *CacheResource) fetchCacheDns(ctx context.Context, id string, data *CacheResourceModel) error {
+	dns, err := r.client.GetCacheDns(ctx, id)
+	if err != nil {
+		if data.PublicHostname.IsUnknown() {
+			data.PublicHostname = types.StringNull()
+		}
+		if client.IsNotFound(err) {
+			tflog.Debug(ctx, "Cache DNS state not available", map[string]interface{}{
+				"id":    id,
+				"error": err.Error(),
+			})
+			return nil
+		}
+		return err
+	}
+	mapCacheDnsToState(dns, data)
+	return nil
+}
+
+func mapCacheDnsToState(dns *client.DnsStatus, data *CacheResourceModel) {
+	data.DnsEnabled = types.BoolValue(dns.Enabled)
+	if dns.Enabled && dns.Hostname != "" {
+		data.PublicHostname = types.StringValue(dns.Hostname)
+	} else {
+		data.PublicHostname = types.StringNull()
+	}
+}
+
+// mapRotatedCacheCredentials stores the credentials returned by a password
+// rotation.
+func mapRotatedCacheCredentials(ctx context.Context, rotated *client.RotatedCredentials, data *CacheResourceModel) {
+	data.Password = types.StringValue(rotated.Password)
+	data.ConnectionInfo = types.StringValue(rotated.ConnectionInfo)
+	data.PasswordRotatedAt = types.StringValue(rotated.RotatedAt)
+	data.ConnectionStrings = connectionStringsValue(ctx, cacheConnectionDetails(data))
 }
@@ -1,10 +1,18 @@
 package resources
 
 import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/AdrianSilaghi/terraform-provider-danubedata/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
 func TestMapCacheToState_PreservesParameterGroupIDWhenAbsent(t *testing.T) {
@@ -84,3 +92,93 @@ func TestMapCacheToState_ProviderFallsBackToLowercasedName(t *testing.T) {
 		t.Errorf("CacheProvider = %v, want redis (lowercased fallback)", data.CacheProvider.ValueString())
 	}
 }
+
+func TestMapCacheDnsToState(t *testing.T) {
+	data := &CacheResourceModel{DnsEnabled: types.BoolValue(true)}
+
+	// Disabled out of band: the live state wins over the prior value.
+	mapCacheDnsToState(&client.DnsStatus{Enabled: false}, data)
+	if data.DnsEnabled.ValueBool() {
+		t.Error("DnsEnabled = true, want false")
+	}
+	if !data.PublicHostname.IsNull() {
+		t.Errorf("PublicHostname = %v, want null", data.PublicHostname)
+	}
+
+	mapCacheDnsToState(&client.DnsStatus{Enabled: true, Hostname: "cache-1.cache.danubedata.ro"}, data)
+	if data.PublicHostname.ValueString() != "cache-1.cache.danubedata.ro" {
+		t.Errorf("PublicHostname = %v, want cache-1.cache.danubedata.ro", data.PublicHostname)
+	}
+}
+
+func TestCacheCreate_EnablesPlannedDns(t *testing.T) {
+	ctx := context.Background()
+	dnsEnabled := false
+	endpoint, port := "cache-1.internal", 6379
+	instance := client.CacheInstance{
+		ID: "cache-1", Name: "my-cache", Status: "running", Datacenter: "fsn1", ResourceProfile: "small",
+		Provider: client.CacheProvider{ID: 1, Name: "Redis", Type: "redis"}, Endpoint: &endpoint, Port: &port,
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == "POST" && r.URL.Path == "/cache":
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"instance": instance})
+		case r.Method == "GET" && r.URL.Path == "/cache/cache-1":
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"instance": instance})
+		case r.Method == "GET" && r.URL.Path == "/cache/cache-1/connection-info":
+			_ = json.NewEncoder(w).Encode(client.CacheConnectionInfo{ConnectionInfo: "redis://cache-1.internal:6379", Password: "secret"})
+		case r.Method == "GET" && r.URL.Path == "/cache/cache-1/dns":
+			dns := client.DnsStatus{Enabled: dnsEnabled}
+			if dnsEnabled {
+				dns.Hostname = "cache-1.example.net"
+			}
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"data": dns})
+		case r.Method == "POST" && r.URL.Path == "/cache/cache-1/dns":
+			dnsEnabled = true
+		default:
+			t.Errorf("unexpected %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	r := &CacheResource{client: client.New(client.Config{BaseURL: server.URL})}
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	nullValue := tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)
+
+	plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: nullValue}
+	for name, value := range map[string]interface{}{
+		"name":             "my-cache",
+		"cache_provider":   "redis",
+		"datacenter":       "fsn1",
+		"resource_profile": "small",
+		"dns_enabled":      true,
+	} {
+		if diags := plan.SetAttribute(ctx, path.Root(name), value); diags.HasError() {
+			t.Fatalf("setting %s: %v", name, diags)
+		}
+	}
+
+	resp := resource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: nullValue}}
+	r.Create(ctx, resource.CreateRequest{Plan: plan}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected errors: %v", resp.Diagnostics)
+	}
+
+	if !dnsEnabled {
+		t.Error("public DNS was not enabled")
+	}
+	var got CacheResourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &got)...)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected errors: %v", resp.Diagnostics)
+	}
+	if !got.DnsEnabled.ValueBool() {
+		t.Error("dns_enabled = false, want true")
+	}
+	if got.PublicHostname.ValueString() != "cache-1.example.net" {
+		t.Errorf("public_hostname = %v, want cache-1.example.net", got.PublicHostname)
+	}
+}
@@ -0,0 +1,34 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/AdrianSilaghi/terraform-provider-danubedata/internal/connstring"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// connectionStringsValue builds the connection_strings attribute of a database
+// or cache. It is null while the host or password is not available yet.
+func connectionStringsValue(ctx context.Context, d connstring.Details) types.Map {
+	if d.Host == "" || d.Password == "" {
+		return types.MapNull(types.StringType)
+	}
+
+	strs, err := connstring.BuildAll(d)
+	if err != nil {
+		tflog.Debug(ctx, "Connection strings not available", map[string]interface{}{
+			"engine": d.Engine,
+			"error":  err.Error(),
+		})
+		return types.MapNull(types.StringType)
+	}
+
+	elems := make(map[string]attr.Value, len(strs))
+	for format, s := range strs {
+		elems[format] = types.StringValue(s)
+	}
+	m, _ := types.MapValue(types.StringType, elems)
+	return m
+}
@@ -0,0 +1,27 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/AdrianSilaghi/terraform-provider-danubedata/internal/connstring"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestConnectionStringsValue(t *testing.T) {
+	d := connstring.Details{Engine: "mysql", Host: "db-123.mysql.cluster.local", Port: 3306, Username: "admin", Database: "app"}
+
+	if got := connectionStringsValue(context.Background(), d); !got.IsNull() {
+		t.Errorf("connection_strings = %v, want null without a password", got)
+	}
+
+	d.Password = "s3cret"
+	got := connectionStringsValue(context.Background(), d)
+	want := "admin:s3cret@tcp(db-123.mysql.cluster.local:3306)/app"
+	if s, ok := got.Elements()["go"].(types.String); !ok || s.ValueString() != want {
+		t.Errorf("connection_strings[go] = %v, want %q", got.Elements()["go"], want)
+	}
+	if _, ok := got.Elements()["libpq"]; ok {
+		t.Errorf("connection_strings = %v, want no libpq entry for MySQL", got)
+	}
+}
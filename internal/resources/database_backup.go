@@ -0,0 +1,221 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/AdrianSilaghi/terraform-provider-danubedata/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// DatabaseBackupModel is the backup attribute of danubedata_database.
+type DatabaseBackupModel struct {
+	Enabled          types.Bool   `tfsdk:"enabled"`
+	Window           types.String `tfsdk:"window"`
+	RetentionDays    types.Int64  `tfsdk:"retention_days"`
+	LogRetentionDays types.Int64  `tfsdk:"log_retention_days"`
+}
+
+// databaseBackupAttrTypes describes the object type of the backup attribute.
+var databaseBackupAttrTypes = map[string]attr.Type{
+	"enabled":            types.BoolType,
+	"window":             types.StringType,
+	"retention_days":     types.Int64Type,
+	"log_retention_days": types.Int64Type,
+}
+
+// databaseBackupAttribute returns the schema of the backup attribute. Every
+// field is Optional and Computed: unset fields keep the value the API reports,
+// so the instance's defaults are shown rather than overridden.
+func databaseBackupAttribute() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Description: "Automated backup schedule. Unset fields keep their current value, the platform default for new instances.",
+		Optional:    true,
+		Computed:    true,
+		PlanModifiers: []planmodifier.Object{
+			objectplanmodifier.UseStateForUnknown(),
+		},
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				Description: "Whether daily full backups are taken.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"window": schema.StringAttribute{
+				Description: "Daily UTC window the full backup starts in, as HH:MM-HH:MM, e.g. 03:00-04:00.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(`^([01][0-9]|2[0-3]):[0-5][0-9]-([01][0-9]|2[0-3]):[0-5][0-9]$`),
+						"must be a UTC time range as HH:MM-HH:MM, e.g. 03:00-04:00",
+					),
+				},
+			},
+			"retention_days": schema.Int64Attribute{
+				Description: "Days full backups are kept. Between 1 and 35.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.Between(1, 35),
+				},
+			},
+			"log_retention_days": schema.Int64Attribute{
+				Description: "Days binlogs (MySQL, MariaDB) or WAL (PostgreSQL) are kept for point-in-time recovery. Between 0 and 35, at most retention_days; 0 disables point-in-time recovery.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.Between(0, 35),
+				},
+			},
+		},
+	}
+}
+
+// validateDatabaseBackup checks the backup fields against each other.
+func validateDatabaseBackup(ctx context.Context, obj types.Object, diags *diag.Diagnostics) {
+	if obj.IsNull() || obj.IsUnknown() {
+		return
+	}
+
+	var backup DatabaseBackupModel
+	diags.Append(obj.As(ctx, &backup, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return
+	}
+
+	logRetention := backup.LogRetentionDays
+	if logRetention.IsNull() || logRetention.IsUnknown() || logRetention.ValueInt64() == 0 {
+		return
+	}
+
+	if !backup.Enabled.IsUnknown() && !backup.Enabled.IsNull() && !backup.Enabled.ValueBool() {
+		diags.AddAttributeError(
+			path.Root("backup").AtName("log_retention_days"),
+			"Point-in-Time Recovery Without Backups",
+			"log_retention_days requires enabled = true: point-in-time recovery replays logs on top of a full backup. Set log_retention_days = 0 to disable it.",
+		)
+	}
+
+	if !backup.RetentionDays.IsNull() && !backup.RetentionDays.IsUnknown() && logRetention.ValueInt64() > backup.RetentionDays.ValueInt64() {
+		diags.AddAttributeError(
+			path.Root("backup").AtName("log_retention_days"),
+			"Log Retention Exceeds Backup Retention",
+			fmt.Sprintf("log_retention_days (%d) must not exceed retention_days (%d): logs older than the oldest full backup cannot be replayed.",
+				logRetention.ValueInt64(), backup.RetentionDays.ValueInt64()),
+		)
+	}
+}
+
+// databaseBackupUpdate returns the request that moves the backup schedule from
+// prior to planned, with only the known fields that differ set, and whether
+// there is anything to send.
+func databaseBackupUpdate(ctx context.Context, planned, prior types.Object, diags *diag.Diagnostics) (client.UpdateDatabaseBackupConfigRequest, bool) {
+	var req client.UpdateDatabaseBackupConfigRequest
+	if planned.IsNull() || planned.IsUnknown() {
+		return req, false
+	}
+
+	var plan DatabaseBackupModel
+	diags.Append(planned.As(ctx, &plan, basetypes.ObjectAsOptions{})...)
+	state := DatabaseBackupModel{
+		Enabled:          types.BoolNull(),
+		Window:           types.StringNull(),
+		RetentionDays:    types.Int64Null(),
+		LogRetentionDays: types.Int64Null(),
+	}
+	if !prior.IsNull() && !prior.IsUnknown() {
+		diags.Append(prior.As(ctx, &state, basetypes.ObjectAsOptions{})...)
+	}
+	if diags.HasError() {
+		return req, false
+	}
+
+	changed := false
+	if !plan.Enabled.IsNull() && !plan.Enabled.IsUnknown() && !plan.Enabled.Equal(state.Enabled) {
+		enabled := plan.Enabled.ValueBool()
+		req.Enabled = &enabled
+		changed = true
+	}
+	if !plan.Window.IsNull() && !plan.Window.IsUnknown() && !plan.Window.Equal(state.Window) {
+		window := plan.Window.ValueString()
+		req.Window = &window
+		changed = true
+	}
+	if !plan.RetentionDays.IsNull() && !plan.RetentionDays.IsUnknown() && !plan.RetentionDays.Equal(state.RetentionDays) {
+		retention := int(plan.RetentionDays.ValueInt64())
+		req.RetentionDays = &retention
+		changed = true
+	}
+	if !plan.LogRetentionDays.IsNull() && !plan.LogRetentionDays.IsUnknown() && !plan.LogRetentionDays.Equal(state.LogRetentionDays) {
+		logRetention := int(plan.LogRetentionDays.ValueInt64())
+		req.LogRetentionDays = &logRetention
+		changed = true
+	}
+	return req, changed
+}
+
+// flattenDatabaseBackupConfig converts a backup schedule from the API into the
+// backup attribute.
+func flattenDatabaseBackupConfig(config *client.DatabaseBackupConfig) types.Object {
+	return types.ObjectValueMust(databaseBackupAttrTypes, map[string]attr.Value{
+		"enabled":            types.BoolValue(config.Enabled),
+		"window":             stringOrNull(config.Window),
+		"retention_days":     types.Int64Value(int64(config.RetentionDays)),
+		"log_retention_days": types.Int64Value(int64(config.LogRetentionDays)),
+	})
+}
+
+// checkRestoreTime reports whether restoreTo lies within the restorable range
+// of the source instance's backups.
+func checkRestoreTime(restoreTo string, backups *client.DatabaseBackupList) error {
+	if backups.EarliestRestorableTime == nil || backups.LatestRestorableTime == nil {
+		return fmt.Errorf("the source database has no completed backup to restore from")
+	}
+
+	target, err := time.Parse(time.RFC3339, restoreTo)
+	if err != nil {
+		return fmt.Errorf("restore_to_time %q is not an RFC 3339 timestamp", restoreTo)
+	}
+	earliest, err := time.Parse(time.RFC3339, *backups.EarliestRestorableTime)
+	if err != nil {
+		return fmt.Errorf("unexpected earliest restorable time %q: %w", *backups.EarliestRestorableTime, err)
+	}
+	latest, err := time.Parse(time.RFC3339, *backups.LatestRestorableTime)
+	if err != nil {
+		return fmt.Errorf("unexpected latest restorable time %q: %w", *backups.LatestRestorableTime, err)
+	}
+
+	if target.Before(earliest) || target.After(latest) {
+		return fmt.Errorf("restore_to_time %s is outside the restorable range %s to %s",
+			restoreTo, *backups.EarliestRestorableTime, *backups.LatestRestorableTime)
+	}
+	return nil
+}
@@ -0,0 +1,150 @@
+package resources
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/AdrianSilaghi/terraform-provider-danubedata/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func testDatabaseBackup(enabled types.Bool, window types.String, retention, logRetention types.Int64) types.Object {
+	return types.ObjectValueMust(databaseBackupAttrTypes, map[string]attr.Value{
+		"enabled":            enabled,
+		"window":             window,
+		"retention_days":     retention,
+		"log_retention_days": logRetention,
+	})
+}
+
+func TestValidateDatabaseBackup(t *testing.T) {
+	tests := []struct {
+		name    string
+		backup  types.Object
+		wantErr string
+	}{
+		{name: "not configured", backup: types.ObjectNull(databaseBackupAttrTypes)},
+		{name: "valid", backup: testDatabaseBackup(types.BoolValue(true), types.StringNull(), types.Int64Value(7), types.Int64Value(3))},
+		{name: "log retention unset", backup: testDatabaseBackup(types.BoolValue(false), types.StringNull(), types.Int64Value(7), types.Int64Null())},
+		{name: "pitr disabled with backups disabled", backup: testDatabaseBackup(types.BoolValue(false), types.StringNull(), types.Int64Null(), types.Int64Value(0))},
+		{name: "pitr with backups disabled", backup: testDatabaseBackup(types.BoolValue(false), types.StringNull(), types.Int64Null(), types.Int64Value(3)), wantErr: "requires enabled = true"},
+		{name: "log retention exceeds retention", backup: testDatabaseBackup(types.BoolNull(), types.StringNull(), types.Int64Value(3), types.Int64Value(7)), wantErr: "must not exceed retention_days"},
+		{name: "retention unknown", backup: testDatabaseBackup(types.BoolNull(), types.StringNull(), types.Int64Unknown(), types.Int64Value(7))},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			validateDatabaseBackup(context.Background(), tt.backup, &diags)
+
+			if tt.wantErr == "" {
+				if diags.HasError() {
+					t.Fatalf("unexpected errors: %v", diags)
+				}
+				return
+			}
+			if !diags.HasError() {
+				t.Fatalf("expected error containing %q", tt.wantErr)
+			}
+			if detail := diags.Errors()[0].Detail(); !strings.Contains(detail, tt.wantErr) {
+				t.Errorf("error = %q, want it to contain %q", detail, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestDatabaseBackupUpdate(t *testing.T) {
+	current := testDatabaseBackup(types.BoolValue(true), types.StringValue("03:00-04:00"), types.Int64Value(7), types.Int64Value(0))
+
+	t.Run("not configured", func(t *testing.T) {
+		var diags diag.Diagnostics
+		if _, changed := databaseBackupUpdate(context.Background(), types.ObjectUnknown(databaseBackupAttrTypes), current, &diags); changed {
+			t.Error("changed = true, want false for an unknown plan")
+		}
+	})
+
+	t.Run("unchanged", func(t *testing.T) {
+		var diags diag.Diagnostics
+		if _, changed := databaseBackupUpdate(context.Background(), current, current, &diags); changed {
+			t.Error("changed = true, want false")
+		}
+	})
+
+	t.Run("only differing known fields are sent", func(t *testing.T) {
+		planned := testDatabaseBackup(types.BoolValue(true), types.StringUnknown(), types.Int64Value(14), types.Int64Value(7))
+
+		var diags diag.Diagnostics
+		req, changed := databaseBackupUpdate(context.Background(), planned, current, &diags)
+		if diags.HasError() {
+			t.Fatalf("unexpected errors: %v", diags)
+		}
+		if !changed {
+			t.Fatal("changed = false, want true")
+		}
+		if req.Enabled != nil || req.Window != nil {
+			t.Errorf("Enabled = %v, Window = %v, want both nil", req.Enabled, req.Window)
+		}
+		if req.RetentionDays == nil || *req.RetentionDays != 14 {
+			t.Errorf("RetentionDays = %v, want 14", req.RetentionDays)
+		}
+		if req.LogRetentionDays == nil || *req.LogRetentionDays != 7 {
+			t.Errorf("LogRetentionDays = %v, want 7", req.LogRetentionDays)
+		}
+	})
+
+	t.Run("no prior schedule", func(t *testing.T) {
+		planned := testDatabaseBackup(types.BoolValue(false), types.StringUnknown(), types.Int64Unknown(), types.Int64Unknown())
+
+		var diags diag.Diagnostics
+		req, changed := databaseBackupUpdate(context.Background(), planned, types.ObjectNull(databaseBackupAttrTypes), &diags)
+		if !changed || req.Enabled == nil || *req.Enabled {
+			t.Errorf("changed = %v, Enabled = %v, want true and false", changed, req.Enabled)
+		}
+	})
+}
+
+func TestFlattenDatabaseBackupConfig(t *testing.T) {
+	got := flattenDatabaseBackupConfig(&client.DatabaseBackupConfig{Enabled: false, RetentionDays: 7})
+	want := testDatabaseBackup(types.BoolValue(false), types.StringNull(), types.Int64Value(7), types.Int64Value(0))
+
+	if !got.Equal(want) {
+		t.Errorf("flattenDatabaseBackupConfig() = %v, want %v", got, want)
+	}
+}
+
+func TestCheckRestoreTime(t *testing.T) {
+	earliest, latest := "2026-10-01T03:12:00Z", "2026-10-02T08:30:00Z"
+	backups := &client.DatabaseBackupList{EarliestRestorableTime: &earliest, LatestRestorableTime: &latest}
+
+	tests := []struct {
+		name      string
+		restoreTo string
+		backups   *client.DatabaseBackupList
+		wantErr   string
+	}{
+		{name: "within range", restoreTo: "2026-10-02T00:00:00Z", backups: backups},
+		{name: "earliest", restoreTo: earliest, backups: backups},
+		{name: "other offset", restoreTo: "2026-10-02T10:30:00+02:00", backups: backups},
+		{name: "too early", restoreTo: "2026-10-01T03:11:59Z", backups: backups, wantErr: "outside the restorable range"},
+		{name: "too late", restoreTo: "2026-10-02T08:30:01Z", backups: backups, wantErr: "outside the restorable range"},
+		{name: "no backups", restoreTo: "2026-10-02T00:00:00Z", backups: &client.DatabaseBackupList{}, wantErr: "no completed backup"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkRestoreTime(tt.restoreTo, tt.backups)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("error = %v, want it to contain %q", err, tt.wantErr)
+			}
+		})
+	}
+}
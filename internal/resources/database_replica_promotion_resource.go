@@ -0,0 +1,287 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/AdrianSilaghi/terraform-provider-danubedata/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &DatabaseReplicaPromotionResource{}
+	_ resource.ResourceWithConfigure   = &DatabaseReplicaPromotionResource{}
+	_ resource.ResourceWithImportState = &DatabaseReplicaPromotionResource{}
+)
+
+type DatabaseReplicaPromotionResource struct {
+	client *client.Client
+}
+
+type DatabaseReplicaPromotionResourceModel struct {
+	ID                 types.String   `tfsdk:"id"`
+	DatabaseInstanceID types.String   `tfsdk:"database_instance_id"`
+	ReplicaIndex       types.Int64    `tfsdk:"replica_index"`
+	Name               types.String   `tfsdk:"name"`
+	DatabaseID         types.String   `tfsdk:"database_id"`
+	Endpoint           types.String   `tfsdk:"endpoint"`
+	PromotedAt         types.String   `tfsdk:"promoted_at"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
+}
+
+func NewDatabaseReplicaPromotionResource() resource.Resource {
+	return &DatabaseReplicaPromotionResource{}
+}
+
+func (r *DatabaseReplicaPromotionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_database_replica_promotion"
+}
+
+func (r *DatabaseReplicaPromotionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Promotes a read replica to a standalone database instance, e.g. for disaster recovery. " +
+			"Creating this resource detaches the replica from its primary and waits until it accepts writes. " +
+			"The promoted instance can then be imported into a danubedata_database resource. Destroying this resource does not affect the promoted instance.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the promoted database instance. Same as database_id.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"database_instance_id": schema.StringAttribute{
+				Description: "ID of the primary database instance the replica belongs to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"replica_index": schema.Int64Attribute{
+				Description: "Index of the replica to promote, as reported by danubedata_database_replica.",
+				Required:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the promoted instance. Defaults to the replica's name. Must be lowercase alphanumeric with hyphens (DNS compatible).",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`),
+						"must be lowercase alphanumeric with hyphens (DNS compatible)",
+					),
+					stringvalidator.LengthBetween(2, 63),
+				},
+			},
+			"database_id": schema.StringAttribute{
+				Description: "ID of the promoted database instance, for importing it into a danubedata_database resource.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"endpoint": schema.StringAttribute{
+				Description: "Connection endpoint of the promoted instance.",
+				Computed:    true,
+			},
+			"promoted_at": schema.StringAttribute{
+				Description: "Time the replica was promoted.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
+	}
+}
+
+func (r *DatabaseReplicaPromotionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+	r.client = c
+}
+
+func (r *DatabaseReplicaPromotionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DatabaseReplicaPromotionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, 30*time.Minute)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	instanceID := data.DatabaseInstanceID.ValueString()
+	idx := int(data.ReplicaIndex.ValueInt64())
+
+	// Fail with a clear message before anything changes if the replica does
+	// not exist, e.g. because it was already promoted.
+	if _, err := r.client.FindDatabaseReplica(ctx, instanceID, idx); err != nil {
+		resp.Diagnostics.AddError("Cannot Promote Database Replica", fmt.Sprintf("Replica %s:%d: %s", instanceID, idx, err))
+		return
+	}
+
+	promoteReq := client.PromoteDatabaseReplicaRequest{}
+	if !data.Name.IsNull() && !data.Name.IsUnknown() {
+		promoteReq.Name = data.Name.ValueString()
+	}
+
+	tflog.Info(ctx, "Promoting database replica", map[string]interface{}{
+		"database_instance_id": instanceID,
+		"replica_index":        idx,
+	})
+
+	promoted, err := r.client.PromoteDatabaseReplica(ctx, instanceID, idx, promoteReq)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to promote database replica", err.Error())
+		return
+	}
+
+	data.PromotedAt = types.StringValue(time.Now().UTC().Format(time.RFC3339))
+	mapPromotedDatabaseToState(promoted, &data)
+
+	// The replica is detached at this point, so record the new instance
+	// before waiting: a failed wait must not lose track of it.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.WaitForDatabaseWritable(ctx, promoted.ID, createTimeout); err != nil {
+		resp.Diagnostics.AddError(
+			"Promoted database did not accept writes",
+			fmt.Sprintf("Replica %s:%d was promoted to database %s, which did not accept writes: %s", instanceID, idx, promoted.ID, err),
+		)
+		return
+	}
+
+	database, err := r.client.GetDatabase(ctx, promoted.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read promoted database", err.Error())
+		return
+	}
+
+	mapPromotedDatabaseToState(database, &data)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DatabaseReplicaPromotionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DatabaseReplicaPromotionResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	database, err := r.client.GetDatabase(ctx, data.DatabaseID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Failed to read promoted database", err.Error())
+		return
+	}
+
+	mapPromotedDatabaseToState(database, &data)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DatabaseReplicaPromotionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Every configurable field requires replacement, so Update only runs for
+	// timeouts changes. Keep the state; Read refreshes the computed fields.
+	var data, plan DatabaseReplicaPromotionResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Timeouts = plan.Timeouts
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DatabaseReplicaPromotionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// A promotion cannot be undone, and the promoted instance holds data, so
+	// there is nothing to delete: the instance keeps running.
+	tflog.Info(ctx, "Removing database replica promotion from state; the promoted database keeps running")
+}
+
+func (r *DatabaseReplicaPromotionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Expected format: {database_instance_id}:{replica_index}:{database_id}.
+	// The replica the instance was promoted from is part of the ID, so that
+	// the arguments match the configuration and do not force a new promotion.
+	parts := strings.SplitN(req.ID, ":", 3)
+	if len(parts) != 3 || parts[0] == "" || parts[2] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			fmt.Sprintf("Expected format: {database_instance_id}:{replica_index}:{database_id}, got: %s", req.ID),
+		)
+		return
+	}
+	idx, err := strconv.Atoi(parts[1])
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid replica_index in import ID", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[2])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("database_id"), parts[2])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("database_instance_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("replica_index"), int64(idx))...)
+}
+
+func mapPromotedDatabaseToState(database *client.DatabaseInstance, data *DatabaseReplicaPromotionResourceModel) {
+	data.ID = types.StringValue(database.ID)
+	data.DatabaseID = types.StringValue(database.ID)
+	// Keep the name the instance was promoted with, so renaming it through
+	// danubedata_database after import does not replace this resource.
+	if data.Name.IsNull() || data.Name.IsUnknown() {
+		data.Name = types.StringValue(database.Name)
+	}
+	data.Endpoint = types.StringPointerValue(database.Endpoint)
+}
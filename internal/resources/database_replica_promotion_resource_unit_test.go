@@ -0,0 +1,180 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/AdrianSilaghi/terraform-provider-danubedata/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestMapPromotedDatabaseToState(t *testing.T) {
+	endpoint := "db-2.internal:5432"
+	database := &client.DatabaseInstance{ID: "db-2", Name: "app-db-replica-1", Endpoint: &endpoint}
+
+	// Without a configured name, the replica's name is recorded.
+	data := &DatabaseReplicaPromotionResourceModel{Name: types.StringUnknown()}
+	mapPromotedDatabaseToState(database, data)
+	if data.ID.ValueString() != "db-2" || data.DatabaseID.ValueString() != "db-2" {
+		t.Errorf("ID/DatabaseID = %v/%v, want db-2", data.ID, data.DatabaseID)
+	}
+	if data.Name.ValueString() != "app-db-replica-1" {
+		t.Errorf("Name = %v, want app-db-replica-1", data.Name)
+	}
+	if data.Endpoint.ValueString() != endpoint {
+		t.Errorf("Endpoint = %v, want %s", data.Endpoint, endpoint)
+	}
+
+	// A rename through danubedata_database keeps the promoted name.
+	database.Name = "app-db-primary"
+	database.Endpoint = nil
+	mapPromotedDatabaseToState(database, data)
+	if data.Name.ValueString() != "app-db-replica-1" {
+		t.Errorf("Name = %v, want the promoted app-db-replica-1", data.Name)
+	}
+	if !data.Endpoint.IsNull() {
+		t.Errorf("Endpoint = %v, want null", data.Endpoint)
+	}
+}
+
+func TestDatabaseReplicaPromotionCreate_RecordsStateBeforeWait(t *testing.T) {
+	ctx := context.Background()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/database/db-1/replicas":
+			_ = json.NewEncoder(w).Encode(client.DatabaseReplicaList{
+				Replicas: []client.DatabaseReplica{{Name: "app-db-replica-1", ReplicaIndex: 1, Status: "running"}},
+			})
+		case r.Method == "POST" && r.URL.Path == "/database/db-1/replicas/1/promote":
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"instance": client.DatabaseInstance{ID: "db-2", Name: "app-db-dr", Status: "promoting", ReadOnly: true},
+			})
+		case r.Method == "GET" && r.URL.Path == "/database/db-2":
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"instance": client.DatabaseInstance{ID: "db-2", Name: "app-db-dr", Status: "error", ReadOnly: true},
+			})
+		default:
+			t.Errorf("unexpected %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	r := &DatabaseReplicaPromotionResource{client: client.New(client.Config{BaseURL: server.URL})}
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	nullValue := tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)
+
+	plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: nullValue}
+	for name, value := range map[string]interface{}{
+		"database_instance_id": "db-1",
+		"replica_index":        int64(1),
+		"name":                 "app-db-dr",
+	} {
+		if diags := plan.SetAttribute(ctx, path.Root(name), value); diags.HasError() {
+			t.Fatalf("setting %s: %v", name, diags)
+		}
+	}
+
+	resp := resource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: nullValue}}
+	r.Create(ctx, resource.CreateRequest{Plan: plan}, &resp)
+	if !resp.Diagnostics.HasError() || !strings.Contains(resp.Diagnostics.Errors()[0].Detail(), "db-2") {
+		t.Fatalf("errors = %v, want a failed wait naming db-2", resp.Diagnostics)
+	}
+
+	// The replica is already detached, so the new instance must be tracked.
+	var got DatabaseReplicaPromotionResourceModel
+	if diags := resp.State.Get(ctx, &got); diags.HasError() {
+		t.Fatalf("unexpected errors: %v", diags)
+	}
+	if got.DatabaseID.ValueString() != "db-2" {
+		t.Errorf("database_id = %v, want db-2", got.DatabaseID)
+	}
+}
+
+func TestDatabaseReplicaPromotionUpdate_KeepsPlannedTimeouts(t *testing.T) {
+	ctx := context.Background()
+	r := &DatabaseReplicaPromotionResource{}
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	nullValue := tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)
+
+	state := tfsdk.State{Schema: schemaResp.Schema, Raw: nullValue}
+	plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: nullValue}
+	for name, value := range map[string]interface{}{
+		"id":                   "db-2",
+		"database_id":          "db-2",
+		"database_instance_id": "db-1",
+		"replica_index":        int64(1),
+	} {
+		if diags := state.SetAttribute(ctx, path.Root(name), value); diags.HasError() {
+			t.Fatalf("setting %s: %v", name, diags)
+		}
+		if diags := plan.SetAttribute(ctx, path.Root(name), value); diags.HasError() {
+			t.Fatalf("setting %s: %v", name, diags)
+		}
+	}
+	if diags := plan.SetAttribute(ctx, path.Root("timeouts").AtName("create"), "2h"); diags.HasError() {
+		t.Fatalf("setting timeouts: %v", diags)
+	}
+
+	resp := resource.UpdateResponse{State: state}
+	r.Update(ctx, resource.UpdateRequest{Plan: plan, State: state}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected errors: %v", resp.Diagnostics)
+	}
+
+	var got DatabaseReplicaPromotionResourceModel
+	if diags := resp.State.Get(ctx, &got); diags.HasError() {
+		t.Fatalf("unexpected errors: %v", diags)
+	}
+	if got.DatabaseID.ValueString() != "db-2" {
+		t.Errorf("database_id = %v, want db-2", got.DatabaseID)
+	}
+	if create, _ := got.Timeouts.Create(ctx, time.Minute); create != 2*time.Hour {
+		t.Errorf("create timeout = %v, want the planned 2h", create)
+	}
+}
+
+func TestDatabaseReplicaPromotionImportState(t *testing.T) {
+	ctx := context.Background()
+	r := &DatabaseReplicaPromotionResource{}
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	nullValue := tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)
+
+	resp := resource.ImportStateResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: nullValue}}
+	r.ImportState(ctx, resource.ImportStateRequest{ID: "db-1:2:db-2"}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected errors: %v", resp.Diagnostics)
+	}
+	var got DatabaseReplicaPromotionResourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &got)...)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected errors: %v", resp.Diagnostics)
+	}
+	if got.ID.ValueString() != "db-2" || got.DatabaseID.ValueString() != "db-2" {
+		t.Errorf("id/database_id = %v/%v, want db-2", got.ID, got.DatabaseID)
+	}
+	if got.DatabaseInstanceID.ValueString() != "db-1" || got.ReplicaIndex.ValueInt64() != 2 {
+		t.Errorf("database_instance_id/replica_index = %v/%v, want db-1/2", got.DatabaseInstanceID, got.ReplicaIndex)
+	}
+
+	for _, id := range []string{"db-2", "db-1:two:db-2", "db-1:2:"} {
+		resp := resource.ImportStateResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: nullValue}}
+		r.ImportState(ctx, resource.ImportStateRequest{ID: id}, &resp)
+		if !resp.Diagnostics.HasError() {
+			t.Errorf("ImportState(%q) succeeded, want an error", id)
+		}
+	}
+}
@@ -9,11 +9,13 @@ import (
 
 	"github.com/AdrianSilaghi/terraform-provider-danubedata/internal/client"
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -40,6 +42,7 @@ type DatabaseReplicaResourceModel struct {
 	ReplicationStatus    types.String   `tfsdk:"replication_status"`
 	SecondsBehindMaster  types.Int64    `tfsdk:"seconds_behind_master"`
 	IsReplicationHealthy types.Bool     `tfsdk:"is_replication_healthy"`
+	MaxReplicationLag    types.Int64    `tfsdk:"max_replication_lag_seconds"`
 	Timeouts             timeouts.Value `tfsdk:"timeouts"`
 }
 
@@ -73,6 +76,13 @@ func (r *DatabaseReplicaResource) Schema(ctx context.Context, req resource.Schem
 				Description: "1-based index of this replica within the parent instance.",
 				Computed:    true,
 			},
+			"max_replication_lag_seconds": schema.Int64Attribute{
+				Description: "When set, creation only succeeds once replication is healthy and the replica is at most this many seconds behind the master. Only checked at creation.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
 			"name":                   schema.StringAttribute{Computed: true, Description: "Name of the replica node."},
 			"node_id":                schema.StringAttribute{Computed: true, Description: "Internal node identifier."},
 			"endpoint":               schema.StringAttribute{Computed: true, Description: "Connection endpoint for the replica."},
@@ -145,6 +155,15 @@ func (r *DatabaseReplicaResource) Create(ctx context.Context, req resource.Creat
 		}
 	}
 
+	// The replica is running and billed from here on, so record it before
+	// waiting: a failed wait leaves it tainted instead of untracked, and a
+	// retry replaces it rather than adding another.
+	r.mapReplicaToState(instanceID, &newest, &data)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if err := r.client.WaitForDatabaseReplicaReady(ctx, instanceID, newest.ReplicaIndex, createTimeout); err != nil {
 		resp.Diagnostics.AddError(
 			"Database replica failed to become ready",
@@ -153,6 +172,17 @@ func (r *DatabaseReplicaResource) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
+	if !data.MaxReplicationLag.IsNull() {
+		maxLag := int(data.MaxReplicationLag.ValueInt64())
+		if err := r.client.WaitForDatabaseReplicaInSync(ctx, instanceID, newest.ReplicaIndex, maxLag, createTimeout); err != nil {
+			resp.Diagnostics.AddError(
+				"Database replica failed to catch up",
+				fmt.Sprintf("Replica %s:%d did not catch up to within %ds of the master: %s", instanceID, newest.ReplicaIndex, maxLag, err),
+			)
+			return
+		}
+	}
+
 	replica, err := r.client.FindDatabaseReplica(ctx, instanceID, newest.ReplicaIndex)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to read replica after creation", err.Error())
@@ -188,15 +218,20 @@ func (r *DatabaseReplicaResource) Read(ctx context.Context, req resource.ReadReq
 }
 
 func (r *DatabaseReplicaResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// All configurable fields require replacement; Update is only invoked for computed-only
-	// deltas (e.g., timeouts block). Read refreshes computed state on its own — so here we
-	// preserve existing state rather than writing the plan back (which would contain
-	// Unknown values for computed attributes and corrupt state).
-	var data DatabaseReplicaResourceModel
+	// All configurable fields but max_replication_lag_seconds require replacement; Update is
+	// only invoked for that and computed-only deltas (e.g., timeouts block). Read refreshes
+	// computed state on its own — so here we preserve existing state rather than writing the
+	// plan back (which would contain Unknown values for computed attributes and corrupt state).
+	var data, plan DatabaseReplicaResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	// The lag limit only gates creation, so a new value is just recorded.
+	data.MaxReplicationLag = plan.MaxReplicationLag
+	data.Timeouts = plan.Timeouts
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -0,0 +1,69 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/AdrianSilaghi/terraform-provider-danubedata/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestDatabaseReplicaCreate_RecordsStateBeforeWait(t *testing.T) {
+	ctx := context.Background()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == "POST" && r.URL.Path == "/database/db-1/replicas":
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"replicas": []client.DatabaseReplica{
+					{Name: "app-db-replica-1", ReplicaIndex: 1, Status: "running", Ready: true},
+					{Name: "app-db-replica-2", ReplicaIndex: 2, Status: "provisioning"},
+				},
+			})
+		default:
+			t.Errorf("unexpected %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	r := &DatabaseReplicaResource{client: client.New(client.Config{BaseURL: server.URL})}
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	nullValue := tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)
+
+	plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: nullValue}
+	for name, value := range map[string]interface{}{
+		"database_instance_id":        "db-1",
+		"max_replication_lag_seconds": int64(5),
+	} {
+		if diags := plan.SetAttribute(ctx, path.Root(name), value); diags.HasError() {
+			t.Fatalf("setting %s: %v", name, diags)
+		}
+	}
+	// The create timeout expires before the replica is first polled.
+	if diags := plan.SetAttribute(ctx, path.Root("timeouts").AtName("create"), "10ms"); diags.HasError() {
+		t.Fatalf("setting timeouts: %v", diags)
+	}
+
+	resp := resource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: nullValue}}
+	r.Create(ctx, resource.CreateRequest{Plan: plan}, &resp)
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("Create succeeded, want the wait to fail")
+	}
+
+	// The new replica is already billed, so it must be tracked.
+	var got DatabaseReplicaResourceModel
+	if diags := resp.State.Get(ctx, &got); diags.HasError() {
+		t.Fatalf("unexpected errors: %v", diags)
+	}
+	if got.ID.ValueString() != "db-1:2" || got.ReplicaIndex.ValueInt64() != 2 {
+		t.Errorf("id/replica_index = %v/%v, want db-1:2/2", got.ID, got.ReplicaIndex)
+	}
+}
@@ -1,10 +1,18 @@
 package resources
 
 import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/AdrianSilaghi/terraform-provider-danubedata/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
 func TestMapDatabaseToState_PreservesParameterGroupIDWhenAbsent(t *testing.T) {
@@ -111,3 +119,329 @@ func TestDatabaseNeedsStorageGrow(t *testing.T) {
 		})
 	}
 }
+
+func TestMapDatabaseToState_AutoscaledStorageIsNotDrift(t *testing.T) {
+	r := &DatabaseResource{}
+	usedGB := 27.0
+	database := &client.DatabaseInstance{
+		ID:                 "db-1",
+		StorageSizeGB:      30,
+		StorageUsedGB:      &usedGB,
+		StorageAutoscaling: &client.StorageAutoscaling{Enabled: true, MaxStorageGB: 100, ThresholdPercent: 90, IncrementGB: 10},
+	}
+
+	data := &DatabaseResourceModel{StorageSizeGB: types.Int64Value(20)}
+	r.mapDatabaseToState(database, data)
+	if data.StorageSizeGB.ValueInt64() != 20 {
+		t.Errorf("StorageSizeGB = %v, want the configured 20", data.StorageSizeGB)
+	}
+	if data.StorageUsedPercent.ValueFloat64() != 90 {
+		t.Errorf("StorageUsedPercent = %v, want 90 of the grown size", data.StorageUsedPercent)
+	}
+
+	// Disabling autoscaling keeps the grown storage, which cannot be shrunk
+	// back, so the configured size still applies.
+	database.StorageAutoscaling.Enabled = false
+	data = &DatabaseResourceModel{StorageSizeGB: types.Int64Value(20)}
+	r.mapDatabaseToState(database, data)
+	if data.StorageSizeGB.ValueInt64() != 20 {
+		t.Errorf("StorageSizeGB = %v, want the configured 20 after disabling autoscaling", data.StorageSizeGB)
+	}
+
+	// A configured size the instance has not reached yet is drift.
+	data = &DatabaseResourceModel{StorageSizeGB: types.Int64Value(40)}
+	r.mapDatabaseToState(database, data)
+	if data.StorageSizeGB.ValueInt64() != 30 {
+		t.Errorf("StorageSizeGB = %v, want 30", data.StorageSizeGB)
+	}
+
+	// Autoscaling enabled in the prior state still counts when the API no
+	// longer reports it.
+	enabled := flattenStorageAutoscaling(&client.StorageAutoscaling{Enabled: true, MaxStorageGB: 100})
+	database.StorageAutoscaling = nil
+	data = &DatabaseResourceModel{StorageSizeGB: types.Int64Value(20), StorageAutoscaling: enabled}
+	r.mapDatabaseToState(database, data)
+	if data.StorageSizeGB.ValueInt64() != 20 {
+		t.Errorf("StorageSizeGB = %v, want the configured 20 with autoscaling in the prior state", data.StorageSizeGB)
+	}
+}
+
+func TestMapDatabaseToState_ResizeWithoutAutoscalingIsDrift(t *testing.T) {
+	r := &DatabaseResource{}
+	for name, autoscaling := range map[string]*client.StorageAutoscaling{
+		"absent":        nil,
+		"never enabled": {ThresholdPercent: 80, IncrementGB: 10},
+	} {
+		// Autoscaling never grew this instance, so the larger size was set
+		// out of band.
+		database := &client.DatabaseInstance{ID: "db-1", StorageSizeGB: 30, StorageAutoscaling: autoscaling}
+		data := &DatabaseResourceModel{
+			StorageSizeGB:      types.Int64Value(20),
+			StorageAutoscaling: flattenStorageAutoscaling(autoscaling),
+		}
+		r.mapDatabaseToState(database, data)
+		if data.StorageSizeGB.ValueInt64() != 30 {
+			t.Errorf("%s: StorageSizeGB = %v, want 30", name, data.StorageSizeGB)
+		}
+	}
+}
+
+func TestMapDatabaseDnsToState(t *testing.T) {
+	data := &DatabaseResourceModel{DnsEnabled: types.BoolValue(false)}
+
+	// Enabled out of band: the live state wins over the prior value.
+	mapDatabaseDnsToState(&client.DnsStatus{Enabled: true, Hostname: "db-1.db.danubedata.ro"}, data)
+	if !data.DnsEnabled.ValueBool() {
+		t.Error("DnsEnabled = false, want true")
+	}
+	if data.PublicHostname.ValueString() != "db-1.db.danubedata.ro" {
+		t.Errorf("PublicHostname = %v, want db-1.db.danubedata.ro", data.PublicHostname)
+	}
+
+	// Enabled, but no hostname assigned yet.
+	mapDatabaseDnsToState(&client.DnsStatus{Enabled: true}, data)
+	if !data.PublicHostname.IsNull() {
+		t.Errorf("PublicHostname = %v, want null", data.PublicHostname)
+	}
+
+	mapDatabaseDnsToState(&client.DnsStatus{Enabled: false, Hostname: "stale.db.danubedata.ro"}, data)
+	if data.DnsEnabled.ValueBool() || !data.PublicHostname.IsNull() {
+		t.Errorf("DnsEnabled/PublicHostname = %v/%v, want false/null", data.DnsEnabled, data.PublicHostname)
+	}
+}
+
+func TestMapDatabaseToState_PendingChangesShowQueuedValues(t *testing.T) {
+	r := &DatabaseResource{}
+	data := &DatabaseResourceModel{}
+	queuedProfile, queuedGroup := "large", "pg-new"
+	current := "pg-old"
+	database := &client.DatabaseInstance{
+		ID:               "db-1",
+		ResourceProfile:  "small",
+		ParameterGroupID: &current,
+		Engine:           client.DatabaseEngine{ID: 1, Name: "mysql"},
+		PendingChanges: &client.PendingChanges{
+			ResourceProfile:  &queuedProfile,
+			ParameterGroupID: &queuedGroup,
+			ScheduledAt:      "2026-10-18T03:00:00Z",
+		},
+	}
+
+	r.mapDatabaseToState(database, data)
+
+	if data.ResourceProfile.ValueString() != "large" {
+		t.Errorf("ResourceProfile = %v, want the queued value large", data.ResourceProfile)
+	}
+	if data.ParameterGroupID.ValueString() != "pg-new" {
+		t.Errorf("ParameterGroupID = %v, want the queued value pg-new", data.ParameterGroupID)
+	}
+	if data.PendingChanges.IsNull() {
+		t.Fatal("PendingChanges = null, want the queued changes")
+	}
+
+	// Once the window has applied them, nothing is pending.
+	database.ResourceProfile = "large"
+	database.PendingChanges = nil
+	r.mapDatabaseToState(database, data)
+	if !data.PendingChanges.IsNull() {
+		t.Errorf("PendingChanges = %v, want null", data.PendingChanges)
+	}
+}
+
+func TestMapDatabaseToState_KeepsConfiguredReleaseSeries(t *testing.T) {
+	r := &DatabaseResource{}
+	database := &client.DatabaseInstance{
+		ID:       "db-1",
+		Version:  "16.4",
+		Provider: client.Provider{Type: "postgresql"},
+	}
+
+	data := &DatabaseResourceModel{Version: types.StringValue("16")}
+	r.mapDatabaseToState(database, data)
+	if data.Version.ValueString() != "16" {
+		t.Errorf("Version = %v, want the configured series 16", data.Version)
+	}
+
+	// After an upgrade, or when nothing was configured, the API value wins.
+	data = &DatabaseResourceModel{Version: types.StringValue("15")}
+	r.mapDatabaseToState(database, data)
+	if data.Version.ValueString() != "16.4" {
+		t.Errorf("Version = %v, want 16.4", data.Version)
+	}
+
+	data = &DatabaseResourceModel{Version: types.StringUnknown()}
+	r.mapDatabaseToState(database, data)
+	if data.Version.ValueString() != "16.4" {
+		t.Errorf("Version = %v, want 16.4", data.Version)
+	}
+}
+
+func TestDatabaseVersionUpgrade(t *testing.T) {
+	state := &DatabaseResourceModel{Engine: types.StringValue("postgresql"), Version: types.StringValue("15.8")}
+
+	tests := []struct {
+		name    string
+		planned types.String
+		want    bool
+	}{
+		{name: "next major", planned: types.StringValue("16"), want: true},
+		{name: "same series", planned: types.StringValue("15")},
+		{name: "unknown", planned: types.StringUnknown()},
+		{name: "not configured", planned: types.StringNull()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan := &DatabaseResourceModel{Engine: state.Engine, Version: tt.planned}
+			if got := databaseVersionUpgrade(plan, state); got != tt.want {
+				t.Errorf("databaseVersionUpgrade() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDatabaseCreate_EnablesPlannedDns(t *testing.T) {
+	ctx := context.Background()
+	dnsEnabled := false
+	instance := client.DatabaseInstance{
+		ID: "db-1", Name: "my-database", Status: "running", Datacenter: "fsn1", ResourceProfile: "small",
+		StorageSizeGB: 20, Engine: client.DatabaseEngine{ID: 1, Name: "mysql"},
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == "POST" && r.URL.Path == "/database":
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"instance": instance})
+		case r.Method == "GET" && r.URL.Path == "/database/db-1":
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"instance": instance})
+		case r.Method == "GET" && r.URL.Path == "/database/db-1/credentials":
+			_ = json.NewEncoder(w).Encode(client.DatabaseCredentials{Username: "admin", Password: "secret"})
+		case r.Method == "GET" && r.URL.Path == "/database/db-1/backup-config":
+			w.WriteHeader(http.StatusNotFound)
+		case r.Method == "GET" && r.URL.Path == "/database/db-1/dns":
+			dns := client.DnsStatus{Enabled: dnsEnabled}
+			if dnsEnabled {
+				dns.Hostname = "db-1.example.net"
+			}
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"data": dns})
+		case r.Method == "POST" && r.URL.Path == "/database/db-1/dns":
+			dnsEnabled = true
+		default:
+			t.Errorf("unexpected %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	r := &DatabaseResource{client: client.New(client.Config{BaseURL: server.URL})}
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	nullValue := tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)
+
+	plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: nullValue}
+	for name, value := range map[string]interface{}{
+		"name":             "my-database",
+		"engine":           "mysql",
+		"datacenter":       "fsn1",
+		"resource_profile": "small",
+		"dns_enabled":      true,
+	} {
+		if diags := plan.SetAttribute(ctx, path.Root(name), value); diags.HasError() {
+			t.Fatalf("setting %s: %v", name, diags)
+		}
+	}
+
+	resp := resource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: nullValue}}
+	r.Create(ctx, resource.CreateRequest{Plan: plan}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected errors: %v", resp.Diagnostics)
+	}
+
+	if !dnsEnabled {
+		t.Error("public DNS was not enabled")
+	}
+	var got DatabaseResourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &got)...)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected errors: %v", resp.Diagnostics)
+	}
+	if !got.DnsEnabled.ValueBool() {
+		t.Error("dns_enabled = false, want true")
+	}
+	if got.PublicHostname.ValueString() != "db-1.example.net" {
+		t.Errorf("public_hostname = %v, want db-1.example.net", got.PublicHostname)
+	}
+}
+
+func TestDatabaseRead_DnsErrors(t *testing.T) {
+	ctx := context.Background()
+	instance := client.DatabaseInstance{
+		ID: "db-1", Name: "my-database", Status: "running", Datacenter: "fsn1", ResourceProfile: "small",
+		StorageSizeGB: 20, Engine: client.DatabaseEngine{ID: 1, Name: "mysql"},
+	}
+
+	tests := []struct {
+		name       string
+		dnsStatus  int
+		wantErr    bool
+		wantDnsSet bool
+	}{
+		// An instance without the DNS endpoint keeps its prior dns_enabled.
+		{name: "endpoint not available", dnsStatus: http.StatusNotFound, wantDnsSet: true},
+		{name: "server error", dnsStatus: http.StatusInternalServerError, wantErr: true},
+		{name: "unauthorized", dnsStatus: http.StatusUnauthorized, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				switch {
+				case r.Method == "GET" && r.URL.Path == "/database/db-1":
+					_ = json.NewEncoder(w).Encode(map[string]interface{}{"instance": instance})
+				case r.Method == "GET" && r.URL.Path == "/database/db-1/credentials":
+					_ = json.NewEncoder(w).Encode(client.DatabaseCredentials{Username: "admin", Password: "secret"})
+				case r.Method == "GET" && r.URL.Path == "/database/db-1/backup-config":
+					w.WriteHeader(http.StatusNotFound)
+				case r.Method == "GET" && r.URL.Path == "/database/db-1/dns":
+					w.WriteHeader(tt.dnsStatus)
+					_, _ = w.Write([]byte(`{"message":"unavailable"}`))
+				default:
+					t.Errorf("unexpected %s %s", r.Method, r.URL.Path)
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+			defer server.Close()
+
+			r := &DatabaseResource{client: client.New(client.Config{BaseURL: server.URL})}
+			var schemaResp resource.SchemaResponse
+			r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+			nullValue := tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)
+
+			state := tfsdk.State{Schema: schemaResp.Schema, Raw: nullValue}
+			for name, value := range map[string]interface{}{"id": "db-1", "dns_enabled": true} {
+				if diags := state.SetAttribute(ctx, path.Root(name), value); diags.HasError() {
+					t.Fatalf("setting %s: %v", name, diags)
+				}
+			}
+
+			resp := resource.ReadResponse{State: state}
+			r.Read(ctx, resource.ReadRequest{State: state}, &resp)
+			if resp.Diagnostics.HasError() != tt.wantErr {
+				t.Fatalf("errors = %v, want error %v", resp.Diagnostics, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			var got DatabaseResourceModel
+			resp.Diagnostics.Append(resp.State.Get(ctx, &got)...)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected errors: %v", resp.Diagnostics)
+			}
+			if got.DnsEnabled.ValueBool() != tt.wantDnsSet {
+				t.Errorf("dns_enabled = %v, want %v", got.DnsEnabled, tt.wantDnsSet)
+			}
+		})
+	}
+}
@@ -0,0 +1,377 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/AdrianSilaghi/terraform-provider-danubedata/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &DatabaseSchemaResource{}
+	_ resource.ResourceWithConfigure   = &DatabaseSchemaResource{}
+	_ resource.ResourceWithImportState = &DatabaseSchemaResource{}
+	_ resource.ResourceWithModifyPlan  = &DatabaseSchemaResource{}
+)
+
+// reservedDatabaseSchemas lists the system databases of each engine, which
+// cannot be managed as logical databases.
+var reservedDatabaseSchemas = map[string][]string{
+	"mysql":      {"information_schema", "mysql", "performance_schema", "sys"},
+	"mariadb":    {"information_schema", "mysql", "performance_schema", "sys"},
+	"postgresql": {"postgres", "template0", "template1"},
+}
+
+type DatabaseSchemaResource struct {
+	client *client.Client
+}
+
+type DatabaseSchemaResourceModel struct {
+	ID                 types.String `tfsdk:"id"`
+	DatabaseInstanceID types.String `tfsdk:"database_instance_id"`
+	Name               types.String `tfsdk:"name"`
+	Charset            types.String `tfsdk:"charset"`
+	Collation          types.String `tfsdk:"collation"`
+	Owner              types.String `tfsdk:"owner"`
+	CreatedAt          types.String `tfsdk:"created_at"`
+}
+
+func NewDatabaseSchemaResource() resource.Resource {
+	return &DatabaseSchemaResource{}
+}
+
+func (r *DatabaseSchemaResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_database_schema"
+}
+
+func (r *DatabaseSchemaResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a logical database (schema) on a DanubeData database instance. Destroying the resource drops the database and all of its data.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Composite identifier in the form {database_instance_id}:{name}.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"database_instance_id": schema.StringAttribute{
+				Description: "ID of the database instance. Changing this forces a new resource.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the logical database: lowercase letters, digits and underscores, starting with a letter or underscore. Changing this forces a new resource.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(63),
+					stringvalidator.RegexMatches(databaseIdentifier, "must contain only lowercase letters, digits and underscores, and must not start with a digit"),
+				},
+			},
+			"charset": schema.StringAttribute{
+				Description: "Default character set, e.g. 'utf8mb4'. MySQL and MariaDB only; defaults to the instance's character set.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^[a-z0-9]+$`), "must be a character set name, e.g. utf8mb4"),
+				},
+			},
+			"collation": schema.StringAttribute{
+				Description: "Default collation, e.g. 'utf8mb4_unicode_ci'. Must belong to charset. MySQL and MariaDB only; defaults to the character set's default collation.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^[a-z0-9]+(_[a-z0-9]+)*$`), "must be a collation name, e.g. utf8mb4_unicode_ci"),
+				},
+			},
+			"owner": schema.StringAttribute{
+				Description: "User that owns the database, e.g. a danubedata_database_user name. PostgreSQL only; defaults to the instance's default user.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(databaseIdentifier, "must be a database user name"),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Description: "Timestamp when the database was created.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *DatabaseSchemaResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+	r.client = c
+}
+
+// ModifyPlan checks the configured arguments against the engine of the
+// instance, which is only known to the API. The configuration is validated
+// rather than the plan, as charset, collation and owner are computed when
+// omitted.
+func (r *DatabaseSchemaResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var config DatabaseSchemaResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		var state DatabaseSchemaResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		// An omitted collation follows the charset. Keeping the prior one
+		// would send a pair the server rejects, so the new default is left
+		// for the API to fill in.
+		if collationFollowsCharset(&config, &state) {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("collation"), types.StringUnknown())...)
+		}
+		unchanged := config.Name.Equal(state.Name) &&
+			(config.Charset.IsNull() || config.Charset.Equal(state.Charset)) &&
+			(config.Collation.IsNull() || config.Collation.Equal(state.Collation)) &&
+			(config.Owner.IsNull() || config.Owner.Equal(state.Owner))
+		if unchanged {
+			return
+		}
+	}
+
+	if r.client == nil || config.DatabaseInstanceID.IsUnknown() {
+		return
+	}
+
+	instance, err := r.client.GetDatabase(ctx, config.DatabaseInstanceID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError("Failed to read database instance", err.Error())
+		return
+	}
+
+	validateDatabaseSchemaForEngine(databaseEngineType(instance), &config, &resp.Diagnostics)
+}
+
+// collationFollowsCharset reports whether the charset changes while the
+// collation is left to default, so the prior collation no longer applies.
+func collationFollowsCharset(config, state *DatabaseSchemaResourceModel) bool {
+	return config.Collation.IsNull() && !config.Charset.IsNull() && !config.Charset.Equal(state.Charset)
+}
+
+// validateDatabaseSchemaForEngine checks the configured arguments of a
+// logical database against what the engine supports. Unknown and null
+// values are skipped.
+func validateDatabaseSchemaForEngine(engine string, config *DatabaseSchemaResourceModel, diags *diag.Diagnostics) {
+	if !config.Name.IsUnknown() {
+		for _, reserved := range reservedDatabaseSchemas[engine] {
+			if config.Name.ValueString() == reserved {
+				diags.AddAttributeError(path.Root("name"), "Reserved Database Name",
+					fmt.Sprintf("%q is a %s system database and cannot be managed.", reserved, engine))
+			}
+		}
+	}
+
+	switch engine {
+	case "postgresql":
+		for _, attr := range []struct {
+			name  string
+			value types.String
+		}{{"charset", config.Charset}, {"collation", config.Collation}} {
+			if !attr.value.IsNull() {
+				diags.AddAttributeError(path.Root(attr.name), "Unsupported Database Argument",
+					fmt.Sprintf("%s is only supported on MySQL and MariaDB instances.", attr.name))
+			}
+		}
+	case "mysql", "mariadb":
+		if !config.Owner.IsNull() {
+			diags.AddAttributeError(path.Root("owner"), "Unsupported Database Argument",
+				"owner is only supported on PostgreSQL instances. Grant privileges with danubedata_database_user instead.")
+		}
+	}
+
+	if config.Charset.IsNull() || config.Charset.IsUnknown() || config.Collation.IsNull() || config.Collation.IsUnknown() {
+		return
+	}
+	charset := config.Charset.ValueString()
+	collation := config.Collation.ValueString()
+	if charset == "utf8" {
+		// utf8 is an alias of utf8mb3, whose collations newer MySQL versions
+		// name either way.
+		if strings.HasPrefix(collation, "utf8_") || strings.HasPrefix(collation, "utf8mb3_") {
+			return
+		}
+	}
+	if collation != charset && !strings.HasPrefix(collation, charset+"_") {
+		diags.AddAttributeError(path.Root("collation"), "Invalid Collation",
+			fmt.Sprintf("Collation %q does not belong to character set %q.", collation, charset))
+	}
+}
+
+func (r *DatabaseSchemaResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DatabaseSchemaResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	instanceID := data.DatabaseInstanceID.ValueString()
+
+	tflog.Debug(ctx, "Creating logical database", map[string]interface{}{
+		"database_instance_id": instanceID,
+		"name":                 data.Name.ValueString(),
+	})
+
+	dbSchema, err := r.client.CreateDatabaseSchema(ctx, instanceID, client.DatabaseSchemaRequest{
+		Name:      data.Name.ValueString(),
+		Charset:   data.Charset.ValueString(),
+		Collation: data.Collation.ValueString(),
+		Owner:     data.Owner.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create logical database", err.Error())
+		return
+	}
+
+	mapDatabaseSchemaToState(instanceID, dbSchema, &data)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DatabaseSchemaResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DatabaseSchemaResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	instanceID := data.DatabaseInstanceID.ValueString()
+	dbSchema, err := r.client.GetDatabaseSchema(ctx, instanceID, data.Name.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Failed to read logical database", err.Error())
+		return
+	}
+
+	mapDatabaseSchemaToState(instanceID, dbSchema, &data)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DatabaseSchemaResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data DatabaseSchemaResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	instanceID := data.DatabaseInstanceID.ValueString()
+
+	tflog.Debug(ctx, "Updating logical database", map[string]interface{}{
+		"database_instance_id": instanceID,
+		"name":                 data.Name.ValueString(),
+	})
+
+	dbSchema, err := r.client.UpdateDatabaseSchema(ctx, instanceID, data.Name.ValueString(), client.DatabaseSchemaRequest{
+		Charset:   data.Charset.ValueString(),
+		Collation: data.Collation.ValueString(),
+		Owner:     data.Owner.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update logical database", err.Error())
+		return
+	}
+
+	mapDatabaseSchemaToState(instanceID, dbSchema, &data)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DatabaseSchemaResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data DatabaseSchemaResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Dropping logical database", map[string]interface{}{
+		"database_instance_id": data.DatabaseInstanceID.ValueString(),
+		"name":                 data.Name.ValueString(),
+	})
+
+	if err := r.client.DeleteDatabaseSchema(ctx, data.DatabaseInstanceID.ValueString(), data.Name.ValueString()); err != nil {
+		if client.IsNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError("Failed to delete logical database", err.Error())
+		return
+	}
+}
+
+// ImportState imports a logical database using "{database_instance_id}:{name}".
+func (r *DatabaseSchemaResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	instanceID, name, ok := strings.Cut(req.ID, ":")
+	if !ok || instanceID == "" || name == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected import ID in the format {database_instance_id}:{name}, got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("database_instance_id"), instanceID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
+}
+
+func mapDatabaseSchemaToState(instanceID string, dbSchema *client.DatabaseSchema, data *DatabaseSchemaResourceModel) {
+	data.ID = types.StringValue(instanceID + ":" + dbSchema.Name)
+	data.DatabaseInstanceID = types.StringValue(instanceID)
+	data.Name = types.StringValue(dbSchema.Name)
+	data.Charset = stringOrNull(dbSchema.Charset)
+	data.Collation = stringOrNull(dbSchema.Collation)
+	data.Owner = stringOrNull(dbSchema.Owner)
+	data.CreatedAt = types.StringValue(dbSchema.CreatedAt)
+}
@@ -0,0 +1,102 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/AdrianSilaghi/terraform-provider-danubedata/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestValidateDatabaseSchemaForEngine(t *testing.T) {
+	schema := func(name, charset, collation, owner string) *DatabaseSchemaResourceModel {
+		return &DatabaseSchemaResourceModel{
+			Name:      types.StringValue(name),
+			Charset:   stringOrNull(charset),
+			Collation: stringOrNull(collation),
+			Owner:     stringOrNull(owner),
+		}
+	}
+
+	tests := []struct {
+		name    string
+		engine  string
+		data    *DatabaseSchemaResourceModel
+		wantErr string
+	}{
+		{name: "mysql", engine: "mysql", data: schema("orders", "utf8mb4", "utf8mb4_unicode_ci", "")},
+		{name: "mysql defaults", engine: "mysql", data: schema("orders", "", "", "")},
+		{name: "mariadb utf8 alias", engine: "mariadb", data: schema("orders", "utf8", "utf8mb3_general_ci", "")},
+		{name: "binary", engine: "mysql", data: schema("blobs", "binary", "binary", "")},
+		{name: "postgresql", engine: "postgresql", data: schema("orders", "", "", "orders")},
+		{name: "reserved mysql", engine: "mysql", data: schema("sys", "", "", ""), wantErr: "Reserved Database Name"},
+		{name: "reserved postgresql", engine: "postgresql", data: schema("template1", "", "", ""), wantErr: "Reserved Database Name"},
+		{name: "charset on postgresql", engine: "postgresql", data: schema("orders", "utf8mb4", "", ""), wantErr: "Unsupported Database Argument"},
+		{name: "owner on mysql", engine: "mysql", data: schema("orders", "", "", "orders"), wantErr: "Unsupported Database Argument"},
+		{name: "mismatched collation", engine: "mysql", data: schema("orders", "latin1", "utf8mb4_unicode_ci", ""), wantErr: "Invalid Collation"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			validateDatabaseSchemaForEngine(tt.engine, tt.data, &diags)
+			if tt.wantErr == "" {
+				if diags.HasError() {
+					t.Errorf("unexpected errors: %v", diags)
+				}
+				return
+			}
+			if !diags.HasError() || diags.Errors()[0].Summary() != tt.wantErr {
+				t.Errorf("errors = %v, want %q", diags, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestMapDatabaseSchemaToState(t *testing.T) {
+	var data DatabaseSchemaResourceModel
+	mapDatabaseSchemaToState("db-123", &client.DatabaseSchema{
+		Name:      "orders",
+		Owner:     "orders",
+		CreatedAt: "2026-01-01T00:00:00Z",
+	}, &data)
+
+	if data.ID.ValueString() != "db-123:orders" {
+		t.Errorf("ID = %q, want db-123:orders", data.ID.ValueString())
+	}
+	if !data.Charset.IsNull() || !data.Collation.IsNull() {
+		t.Errorf("Charset/Collation = %v/%v, want null on PostgreSQL", data.Charset, data.Collation)
+	}
+	if data.Owner.ValueString() != "orders" {
+		t.Errorf("Owner = %q, want orders", data.Owner.ValueString())
+	}
+}
+
+func TestCollationFollowsCharset(t *testing.T) {
+	state := &DatabaseSchemaResourceModel{
+		Charset:   types.StringValue("latin1"),
+		Collation: types.StringValue("latin1_swedish_ci"),
+	}
+
+	tests := []struct {
+		name      string
+		charset   types.String
+		collation types.String
+		want      bool
+	}{
+		{name: "charset changed", charset: types.StringValue("utf8mb4"), collation: types.StringNull(), want: true},
+		{name: "charset unknown", charset: types.StringUnknown(), collation: types.StringNull(), want: true},
+		{name: "collation configured", charset: types.StringValue("utf8mb4"), collation: types.StringValue("utf8mb4_bin")},
+		{name: "charset unchanged", charset: types.StringValue("latin1"), collation: types.StringNull()},
+		{name: "charset omitted", charset: types.StringNull(), collation: types.StringNull()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := &DatabaseSchemaResourceModel{Charset: tt.charset, Collation: tt.collation}
+			if got := collationFollowsCharset(config, state); got != tt.want {
+				t.Errorf("collationFollowsCharset() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,473 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/AdrianSilaghi/terraform-provider-danubedata/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &DatabaseUserResource{}
+	_ resource.ResourceWithConfigure   = &DatabaseUserResource{}
+	_ resource.ResourceWithImportState = &DatabaseUserResource{}
+	_ resource.ResourceWithModifyPlan  = &DatabaseUserResource{}
+)
+
+// databaseIdentifier matches the names of database users and logical
+// databases: lowercase letters, digits and underscores, so that they never
+// need quoting in SQL.
+var databaseIdentifier = regexp.MustCompile(`^[a-z_][a-z0-9_]*$`)
+
+// databasePrivileges lists the privileges a grant may contain, per engine.
+var databasePrivileges = map[string][]string{
+	"mysql": {
+		"ALL", "ALTER", "ALTER ROUTINE", "CREATE", "CREATE ROUTINE", "CREATE TEMPORARY TABLES",
+		"CREATE VIEW", "DELETE", "DROP", "EVENT", "EXECUTE", "INDEX", "INSERT", "LOCK TABLES",
+		"REFERENCES", "SELECT", "SHOW VIEW", "TRIGGER", "UPDATE",
+	},
+	"postgresql": {
+		"ALL", "CONNECT", "CREATE", "DELETE", "EXECUTE", "INSERT", "REFERENCES", "SELECT",
+		"TEMPORARY", "TRIGGER", "TRUNCATE", "UPDATE", "USAGE",
+	},
+}
+
+func init() {
+	databasePrivileges["mariadb"] = databasePrivileges["mysql"]
+}
+
+// databaseUserNameMaxLength is the longest user name each engine accepts.
+var databaseUserNameMaxLength = map[string]int{
+	"mysql":      32,
+	"mariadb":    80,
+	"postgresql": 63,
+}
+
+type DatabaseUserResource struct {
+	client *client.Client
+}
+
+type DatabaseUserResourceModel struct {
+	ID                 types.String `tfsdk:"id"`
+	DatabaseInstanceID types.String `tfsdk:"database_instance_id"`
+	Name               types.String `tfsdk:"name"`
+	Password           types.String `tfsdk:"password"`
+	Grants             types.Set    `tfsdk:"grants"`
+	CreatedAt          types.String `tfsdk:"created_at"`
+}
+
+type DatabaseGrantModel struct {
+	Database   types.String `tfsdk:"database"`
+	Privileges types.Set    `tfsdk:"privileges"`
+}
+
+// databaseGrantAttrTypes describes the object type of an element of the grants attribute.
+var databaseGrantAttrTypes = map[string]attr.Type{
+	"database":   types.StringType,
+	"privileges": types.SetType{ElemType: types.StringType},
+}
+
+func NewDatabaseUserResource() resource.Resource {
+	return &DatabaseUserResource{}
+}
+
+func (r *DatabaseUserResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_database_user"
+}
+
+func (r *DatabaseUserResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a user of a DanubeData database instance and its privileges on the instance's logical databases. Use one user per application for least-privilege access to a shared instance.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Composite identifier in the form {database_instance_id}:{name}.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"database_instance_id": schema.StringAttribute{
+				Description: "ID of the database instance. Changing this forces a new resource.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "User name: lowercase letters, digits and underscores, starting with a letter or underscore. At most 32 characters on MySQL, 80 on MariaDB and 63 on PostgreSQL. Changing this forces a new resource.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(80),
+					stringvalidator.RegexMatches(databaseIdentifier, "must contain only lowercase letters, digits and underscores, and must not start with a digit"),
+				},
+			},
+			"password": schema.StringAttribute{
+				Description: "Password of the user, 12 to 128 characters. Generated by the API if omitted. Changing it updates the password in place.",
+				Optional:    true,
+				Computed:    true,
+				Sensitive:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(12, 128),
+				},
+			},
+			"grants": schema.SetNestedAttribute{
+				Description: "Privileges of the user, per logical database. Omit it, rather than setting it to an empty set, for a user that can log in but access nothing.",
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"database": schema.StringAttribute{
+							Description: "Name of the logical database, or '*' for every database (MySQL and MariaDB only).",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.Any(
+									stringvalidator.OneOf("*"),
+									stringvalidator.RegexMatches(databaseIdentifier, "must be '*' or a logical database name"),
+								),
+							},
+						},
+						"privileges": schema.SetAttribute{
+							Description: "Privileges on the database, in uppercase, e.g. SELECT, INSERT or ALL. The allowed privileges depend on the engine and are checked at plan time.",
+							Required:    true,
+							ElementType: types.StringType,
+							Validators: []validator.Set{
+								setvalidator.SizeAtLeast(1),
+								setvalidator.ValueStringsAre(
+									stringvalidator.RegexMatches(regexp.MustCompile(`^[A-Z]+( [A-Z]+)*$`), "must be an uppercase privilege name, e.g. SELECT"),
+								),
+							},
+						},
+					},
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Description: "Timestamp when the user was created.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *DatabaseUserResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+	r.client = c
+}
+
+// ModifyPlan checks the user name and grants against the engine of the
+// instance, which is only known to the API.
+func (r *DatabaseUserResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan DatabaseUserResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.DatabaseInstanceID.IsUnknown() {
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		var state DatabaseUserResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() || (plan.Name.Equal(state.Name) && plan.Grants.Equal(state.Grants)) {
+			return
+		}
+	}
+
+	instance, err := r.client.GetDatabase(ctx, plan.DatabaseInstanceID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError("Failed to read database instance", err.Error())
+		return
+	}
+
+	defaultUser := ""
+	if instance.Username != nil {
+		defaultUser = *instance.Username
+	}
+	validateDatabaseUserForEngine(ctx, databaseEngineType(instance), defaultUser, &plan, &resp.Diagnostics)
+}
+
+// validateDatabaseUserForEngine checks the name and grants of a user against
+// what the engine supports. Unknown values are skipped.
+func validateDatabaseUserForEngine(ctx context.Context, engine, defaultUser string, data *DatabaseUserResourceModel, diags *diag.Diagnostics) {
+	if !data.Name.IsUnknown() {
+		name := data.Name.ValueString()
+		switch {
+		case name == defaultUser:
+			diags.AddAttributeError(path.Root("name"), "Reserved Database User",
+				fmt.Sprintf("%q is the default user of the instance and is managed by danubedata_database.", name))
+		case name == "root" || name == "postgres" || strings.HasPrefix(name, "pg_"):
+			diags.AddAttributeError(path.Root("name"), "Reserved Database User",
+				fmt.Sprintf("%q is reserved for the engine's system users.", name))
+		case databaseUserNameMaxLength[engine] > 0 && len(name) > databaseUserNameMaxLength[engine]:
+			diags.AddAttributeError(path.Root("name"), "Invalid Database User Name",
+				fmt.Sprintf("%s user names are at most %d characters; %q has %d.", engine, databaseUserNameMaxLength[engine], name, len(name)))
+		}
+	}
+
+	if data.Grants.IsNull() || data.Grants.IsUnknown() {
+		return
+	}
+	var grants []DatabaseGrantModel
+	diags.Append(data.Grants.ElementsAs(ctx, &grants, false)...)
+	if diags.HasError() {
+		return
+	}
+
+	allowed := make(map[string]bool)
+	for _, privilege := range databasePrivileges[engine] {
+		allowed[privilege] = true
+	}
+
+	seen := make(map[string]bool)
+	for _, grant := range grants {
+		if grant.Database.IsUnknown() || grant.Privileges.IsUnknown() {
+			continue
+		}
+		database := grant.Database.ValueString()
+		attrPath := path.Root("grants")
+
+		if seen[database] {
+			diags.AddAttributeError(attrPath, "Duplicate Database Grant",
+				fmt.Sprintf("Database %q appears in more than one grant. List all of its privileges in a single grant.", database))
+		}
+		seen[database] = true
+
+		if database == "*" && engine == "postgresql" {
+			diags.AddAttributeError(attrPath, "Invalid Database Grant",
+				"PostgreSQL grants are per database; '*' is only supported on MySQL and MariaDB. Add one grant per database.")
+		}
+
+		var privileges []string
+		diags.Append(grant.Privileges.ElementsAs(ctx, &privileges, false)...)
+		for _, privilege := range privileges {
+			if len(allowed) > 0 && !allowed[privilege] {
+				diags.AddAttributeError(attrPath, "Invalid Database Privilege",
+					fmt.Sprintf("%s is not a %s privilege. Allowed: %s.", privilege, engine, strings.Join(databasePrivileges[engine], ", ")))
+			}
+			if privilege == "ALL" && len(privileges) > 1 {
+				diags.AddAttributeError(attrPath, "Invalid Database Privilege",
+					fmt.Sprintf("The grant on %q lists ALL together with other privileges; ALL already includes them.", database))
+			}
+		}
+	}
+}
+
+func (r *DatabaseUserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DatabaseUserResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	instanceID := data.DatabaseInstanceID.ValueString()
+	grants := expandDatabaseGrants(ctx, data.Grants, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating database user", map[string]interface{}{
+		"database_instance_id": instanceID,
+		"name":                 data.Name.ValueString(),
+	})
+
+	user, err := r.client.CreateDatabaseUser(ctx, instanceID, client.CreateDatabaseUserRequest{
+		Name:     data.Name.ValueString(),
+		Password: data.Password.ValueString(),
+		Grants:   grants,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create database user", err.Error())
+		return
+	}
+
+	if data.Password.IsUnknown() {
+		data.Password = types.StringValue(user.Password)
+	}
+	mapDatabaseUserToState(instanceID, user, &data, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DatabaseUserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DatabaseUserResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	instanceID := data.DatabaseInstanceID.ValueString()
+	user, err := r.client.GetDatabaseUser(ctx, instanceID, data.Name.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Failed to read database user", err.Error())
+		return
+	}
+
+	mapDatabaseUserToState(instanceID, user, &data, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DatabaseUserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state DatabaseUserResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	instanceID := data.DatabaseInstanceID.ValueString()
+	updateReq := client.UpdateDatabaseUserRequest{
+		Grants: expandDatabaseGrants(ctx, data.Grants, &resp.Diagnostics),
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !data.Password.Equal(state.Password) && !data.Password.IsNull() && !data.Password.IsUnknown() {
+		password := data.Password.ValueString()
+		updateReq.Password = &password
+	}
+
+	tflog.Debug(ctx, "Updating database user", map[string]interface{}{
+		"database_instance_id": instanceID,
+		"name":                 data.Name.ValueString(),
+		"password_changed":     updateReq.Password != nil,
+	})
+
+	user, err := r.client.UpdateDatabaseUser(ctx, instanceID, data.Name.ValueString(), updateReq)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update database user", err.Error())
+		return
+	}
+
+	mapDatabaseUserToState(instanceID, user, &data, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DatabaseUserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data DatabaseUserResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.DeleteDatabaseUser(ctx, data.DatabaseInstanceID.ValueString(), data.Name.ValueString()); err != nil {
+		if client.IsNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError("Failed to delete database user", err.Error())
+		return
+	}
+}
+
+// ImportState imports a user using "{database_instance_id}:{name}". The
+// password cannot be read back and is null after import.
+func (r *DatabaseUserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	instanceID, name, ok := strings.Cut(req.ID, ":")
+	if !ok || instanceID == "" || name == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected import ID in the format {database_instance_id}:{name}, got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("database_instance_id"), instanceID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("password"), types.StringNull())...)
+}
+
+// expandDatabaseGrants converts the grants attribute into its API form. Unset
+// grants become an empty list, so that the API revokes everything.
+func expandDatabaseGrants(ctx context.Context, set types.Set, diags *diag.Diagnostics) []client.DatabaseGrant {
+	result := []client.DatabaseGrant{}
+	if set.IsNull() || set.IsUnknown() {
+		return result
+	}
+
+	var grants []DatabaseGrantModel
+	diags.Append(set.ElementsAs(ctx, &grants, false)...)
+	for _, grant := range grants {
+		var privileges []string
+		diags.Append(grant.Privileges.ElementsAs(ctx, &privileges, false)...)
+		sort.Strings(privileges)
+		result = append(result, client.DatabaseGrant{
+			Database:   grant.Database.ValueString(),
+			Privileges: privileges,
+		})
+	}
+	return result
+}
+
+func mapDatabaseUserToState(instanceID string, user *client.DatabaseUser, data *DatabaseUserResourceModel, diags *diag.Diagnostics) {
+	data.ID = types.StringValue(instanceID + ":" + user.Name)
+	data.DatabaseInstanceID = types.StringValue(instanceID)
+	data.Name = types.StringValue(user.Name)
+	data.CreatedAt = types.StringValue(user.CreatedAt)
+
+	if len(user.Grants) == 0 {
+		data.Grants = types.SetNull(types.ObjectType{AttrTypes: databaseGrantAttrTypes})
+		return
+	}
+
+	elems := make([]attr.Value, 0, len(user.Grants))
+	for _, grant := range user.Grants {
+		privileges := make([]attr.Value, 0, len(grant.Privileges))
+		for _, privilege := range grant.Privileges {
+			privileges = append(privileges, types.StringValue(strings.ToUpper(privilege)))
+		}
+		privilegeSet, setDiags := types.SetValue(types.StringType, privileges)
+		diags.Append(setDiags...)
+
+		obj, objDiags := types.ObjectValue(databaseGrantAttrTypes, map[string]attr.Value{
+			"database":   types.StringValue(grant.Database),
+			"privileges": privilegeSet,
+		})
+		diags.Append(objDiags...)
+		elems = append(elems, obj)
+	}
+	grants, setDiags := types.SetValue(types.ObjectType{AttrTypes: databaseGrantAttrTypes}, elems)
+	diags.Append(setDiags...)
+	data.Grants = grants
+}
@@ -0,0 +1,119 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/AdrianSilaghi/terraform-provider-danubedata/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func testDatabaseGrants(t *testing.T, grants map[string][]string) types.Set {
+	t.Helper()
+	elems := make([]attr.Value, 0, len(grants))
+	for database, privileges := range grants {
+		privilegeSet, diags := types.SetValueFrom(context.Background(), types.StringType, privileges)
+		if diags.HasError() {
+			t.Fatalf("unexpected errors: %v", diags)
+		}
+		elems = append(elems, types.ObjectValueMust(databaseGrantAttrTypes, map[string]attr.Value{
+			"database":   types.StringValue(database),
+			"privileges": privilegeSet,
+		}))
+	}
+	return types.SetValueMust(types.ObjectType{AttrTypes: databaseGrantAttrTypes}, elems)
+}
+
+func TestValidateDatabaseUserForEngine(t *testing.T) {
+	tests := []struct {
+		name    string
+		engine  string
+		user    string
+		grants  map[string][]string
+		wantErr string
+	}{
+		{name: "mysql", engine: "mysql", user: "orders", grants: map[string][]string{"orders": {"SELECT", "INSERT", "UPDATE", "DELETE"}}},
+		{name: "mysql all databases", engine: "mysql", user: "reporting", grants: map[string][]string{"*": {"SELECT", "SHOW VIEW"}}},
+		{name: "postgresql", engine: "postgresql", user: "orders", grants: map[string][]string{"orders": {"CONNECT", "TEMPORARY"}}},
+		{name: "mariadb long name", engine: "mariadb", user: "a_very_long_service_account_name_01"},
+		{name: "default user", engine: "mysql", user: "admin", wantErr: "Reserved Database User"},
+		{name: "root", engine: "mysql", user: "root", wantErr: "Reserved Database User"},
+		{name: "pg prefix", engine: "postgresql", user: "pg_monitor", wantErr: "Reserved Database User"},
+		{name: "mysql long name", engine: "mysql", user: "a_very_long_service_account_name_01", wantErr: "Invalid Database User Name"},
+		{name: "postgresql privilege on mysql", engine: "mysql", user: "orders", grants: map[string][]string{"orders": {"TRUNCATE"}}, wantErr: "Invalid Database Privilege"},
+		{name: "mysql privilege on postgresql", engine: "postgresql", user: "orders", grants: map[string][]string{"orders": {"SHOW VIEW"}}, wantErr: "Invalid Database Privilege"},
+		{name: "all with others", engine: "mysql", user: "orders", grants: map[string][]string{"orders": {"ALL", "SELECT"}}, wantErr: "Invalid Database Privilege"},
+		{name: "postgresql all databases", engine: "postgresql", user: "orders", grants: map[string][]string{"*": {"CONNECT"}}, wantErr: "Invalid Database Grant"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := &DatabaseUserResourceModel{
+				Name:   types.StringValue(tt.user),
+				Grants: types.SetNull(types.ObjectType{AttrTypes: databaseGrantAttrTypes}),
+			}
+			if tt.grants != nil {
+				data.Grants = testDatabaseGrants(t, tt.grants)
+			}
+
+			var diags diag.Diagnostics
+			validateDatabaseUserForEngine(context.Background(), tt.engine, "admin", data, &diags)
+			if tt.wantErr == "" {
+				if diags.HasError() {
+					t.Errorf("unexpected errors: %v", diags)
+				}
+				return
+			}
+			if !diags.HasError() || diags.Errors()[0].Summary() != tt.wantErr {
+				t.Errorf("errors = %v, want %q", diags, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateDatabaseUserForEngine_DuplicateDatabase(t *testing.T) {
+	read := testDatabaseGrants(t, map[string][]string{"orders": {"SELECT"}}).Elements()[0]
+	write := testDatabaseGrants(t, map[string][]string{"orders": {"INSERT"}}).Elements()[0]
+	data := &DatabaseUserResourceModel{
+		Name:   types.StringValue("orders"),
+		Grants: types.SetValueMust(types.ObjectType{AttrTypes: databaseGrantAttrTypes}, []attr.Value{read, write}),
+	}
+
+	var diags diag.Diagnostics
+	validateDatabaseUserForEngine(context.Background(), "mysql", "admin", data, &diags)
+	if !diags.HasError() || diags.Errors()[0].Summary() != "Duplicate Database Grant" {
+		t.Errorf("errors = %v, want Duplicate Database Grant", diags)
+	}
+}
+
+func TestMapDatabaseUserToState(t *testing.T) {
+	var diags diag.Diagnostics
+	data := DatabaseUserResourceModel{Password: types.StringValue("correct-horse-battery")}
+
+	mapDatabaseUserToState("db-123", &client.DatabaseUser{
+		Name:      "orders",
+		Grants:    []client.DatabaseGrant{{Database: "orders", Privileges: []string{"select", "INSERT"}}},
+		CreatedAt: "2026-01-01T00:00:00Z",
+	}, &data, &diags)
+
+	if diags.HasError() {
+		t.Fatalf("unexpected errors: %v", diags)
+	}
+	if data.ID.ValueString() != "db-123:orders" {
+		t.Errorf("ID = %q, want db-123:orders", data.ID.ValueString())
+	}
+	if data.Password.ValueString() != "correct-horse-battery" {
+		t.Errorf("Password = %q, want it kept from the prior state", data.Password.ValueString())
+	}
+	want := testDatabaseGrants(t, map[string][]string{"orders": {"SELECT", "INSERT"}})
+	if !data.Grants.Equal(want) {
+		t.Errorf("Grants = %v, want %v", data.Grants, want)
+	}
+
+	mapDatabaseUserToState("db-123", &client.DatabaseUser{Name: "orders"}, &data, &diags)
+	if !data.Grants.IsNull() {
+		t.Errorf("Grants = %v, want null without grants", data.Grants)
+	}
+}
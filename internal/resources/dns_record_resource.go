@@ -0,0 +1,447 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/AdrianSilaghi/terraform-provider-danubedata/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                   = &DNSRecordResource{}
+	_ resource.ResourceWithConfigure      = &DNSRecordResource{}
+	_ resource.ResourceWithImportState    = &DNSRecordResource{}
+	_ resource.ResourceWithValidateConfig = &DNSRecordResource{}
+)
+
+var dnsRecordTypes = []string{"A", "AAAA", "CNAME", "MX", "TXT", "SRV", "CAA"}
+
+var (
+	// dnsRecordName matches a record name relative to its zone: "@" for the
+	// apex, "*" or "*.sub" for wildcards, or dot-separated labels. Underscores
+	// are allowed for names such as "_dmarc" or "_sip._tcp".
+	dnsRecordName = regexp.MustCompile(`^(@|\*|(\*\.)?[A-Za-z0-9_]([A-Za-z0-9_-]{0,61}[A-Za-z0-9])?(\.[A-Za-z0-9_]([A-Za-z0-9_-]{0,61}[A-Za-z0-9])?)*)$`)
+
+	// dnsHostname matches a fully qualified hostname, with or without the
+	// trailing dot.
+	dnsHostname = regexp.MustCompile(`^([A-Za-z0-9]([A-Za-z0-9-]{0,61}[A-Za-z0-9])?\.)+[A-Za-z][A-Za-z0-9-]{0,61}[A-Za-z0-9]\.?$`)
+
+	// dnsSRVName matches an SRV record name, which starts with the service and
+	// protocol labels.
+	dnsSRVName = regexp.MustCompile(`^_[A-Za-z0-9-]+\._(tcp|udp|tls)(\..+)?$`)
+
+	// dnsCAAValue matches a CAA record value: flags, tag and quoted value.
+	dnsCAAValue = regexp.MustCompile(`^(\d{1,3}) (issue|issuewild|iodef) "([^"]*)"$`)
+)
+
+type DNSRecordResource struct {
+	client *client.Client
+}
+
+type DNSRecordResourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	ZoneID    types.String `tfsdk:"zone_id"`
+	Name      types.String `tfsdk:"name"`
+	Type      types.String `tfsdk:"type"`
+	Value     types.String `tfsdk:"value"`
+	TTL       types.Int64  `tfsdk:"ttl"`
+	Priority  types.Int64  `tfsdk:"priority"`
+	Weight    types.Int64  `tfsdk:"weight"`
+	Port      types.Int64  `tfsdk:"port"`
+	FQDN      types.String `tfsdk:"fqdn"`
+	CreatedAt types.String `tfsdk:"created_at"`
+	UpdatedAt types.String `tfsdk:"updated_at"`
+}
+
+func NewDNSRecordResource() resource.Resource {
+	return &DNSRecordResource{}
+}
+
+func (r *DNSRecordResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns_record"
+}
+
+func (r *DNSRecordResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a record in a DanubeData DNS zone.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Unique identifier for the record.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"zone_id": schema.StringAttribute{
+				Description: "ID of the DNS zone. Changing this forces a new resource.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Record name relative to the zone, e.g. 'www'; '@' for the zone apex, '*' for a wildcard. Do not append the zone name.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(dnsRecordName, "must be '@', '*' or a record name relative to the zone, e.g. www or _dmarc"),
+				},
+			},
+			"type": schema.StringAttribute{
+				Description: "Record type: A, AAAA, CNAME, MX, TXT, SRV or CAA. Changing this forces a new resource.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(dnsRecordTypes...),
+				},
+			},
+			"value": schema.StringAttribute{
+				Description: "Record value, validated per type: an IPv4 address (A), an IPv6 address (AAAA), a hostname (CNAME, MX, SRV target), text (TXT), or flags, tag and quoted value (CAA, e.g. '0 issue \"letsencrypt.org\"').",
+				Required:    true,
+			},
+			"ttl": schema.Int64Attribute{
+				Description: "Time to live in seconds, between 60 and 86400. Defaults to 3600.",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(3600),
+				Validators: []validator.Int64{
+					int64validator.Between(60, 86400),
+				},
+			},
+			"priority": schema.Int64Attribute{
+				Description: "Priority of an MX or SRV record; lower values are preferred. Required for MX and SRV, not allowed for other types.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(0, 65535),
+				},
+			},
+			"weight": schema.Int64Attribute{
+				Description: "Relative weight of an SRV record among records with the same priority. Required for SRV, not allowed for other types.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(0, 65535),
+				},
+			},
+			"port": schema.Int64Attribute{
+				Description: "Port of the service of an SRV record. Required for SRV, not allowed for other types.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(0, 65535),
+				},
+			},
+			"fqdn": schema.StringAttribute{
+				Description: "Fully qualified name of the record, e.g. 'www.example.com'.",
+				Computed:    true,
+			},
+			"created_at": schema.StringAttribute{
+				Description: "Timestamp when the record was created.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				Description: "Timestamp when the record was last updated.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *DNSRecordResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = c
+}
+
+func (r *DNSRecordResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data DNSRecordResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	validateDNSRecord(&data, &resp.Diagnostics)
+}
+
+// validateDNSRecord checks the record's value, name and MX/SRV fields against
+// its type. Unknown values are skipped.
+func validateDNSRecord(data *DNSRecordResourceModel, diags *diag.Diagnostics) {
+	if data.Type.IsNull() || data.Type.IsUnknown() {
+		return
+	}
+	recordType := data.Type.ValueString()
+
+	if !data.Value.IsNull() && !data.Value.IsUnknown() {
+		if err := validateDNSRecordValue(recordType, data.Value.ValueString()); err != nil {
+			diags.AddAttributeError(path.Root("value"), "Invalid DNS Record Value",
+				fmt.Sprintf("%q is not a valid %s record value: %s.", data.Value.ValueString(), recordType, err))
+		}
+	}
+
+	if !data.Name.IsNull() && !data.Name.IsUnknown() {
+		name := data.Name.ValueString()
+		switch {
+		case recordType == "CNAME" && name == "@":
+			diags.AddAttributeError(path.Root("name"), "Invalid DNS Record Name",
+				"A CNAME record cannot be created at the zone apex, because the apex also holds the zone's SOA and NS records. Use an A or AAAA record instead.")
+		case recordType == "SRV" && !dnsSRVName.MatchString(name):
+			diags.AddAttributeError(path.Root("name"), "Invalid DNS Record Name",
+				fmt.Sprintf("%q is not a valid SRV record name. SRV names start with the service and protocol, e.g. _sip._tcp or _sip._tcp.office.", name))
+		}
+	}
+
+	fields := []struct {
+		name  string
+		value types.Int64
+		types []string
+	}{
+		{name: "priority", value: data.Priority, types: []string{"MX", "SRV"}},
+		{name: "weight", value: data.Weight, types: []string{"SRV"}},
+		{name: "port", value: data.Port, types: []string{"SRV"}},
+	}
+	for _, field := range fields {
+		used := false
+		for _, t := range field.types {
+			used = used || t == recordType
+		}
+		switch {
+		case used && field.value.IsNull():
+			diags.AddAttributeError(path.Root(field.name), "Missing DNS Record Attribute",
+				fmt.Sprintf("%s is required for %s records.", field.name, recordType))
+		case !used && !field.value.IsNull():
+			diags.AddAttributeError(path.Root(field.name), "Invalid DNS Record Attribute",
+				fmt.Sprintf("%s is only used by %s records, not %s.", field.name, strings.Join(field.types, " and "), recordType))
+		}
+	}
+}
+
+// validateDNSRecordValue reports why value is not a valid value for a record
+// of the given type, or nil.
+func validateDNSRecordValue(recordType, value string) error {
+	switch recordType {
+	case "A":
+		if ip := net.ParseIP(value); ip == nil || ip.To4() == nil {
+			return fmt.Errorf("must be an IPv4 address, e.g. 203.0.113.7")
+		}
+	case "AAAA":
+		if ip := net.ParseIP(value); ip == nil || ip.To4() != nil {
+			return fmt.Errorf("must be an IPv6 address, e.g. 2001:db8::7")
+		}
+	case "CNAME", "MX":
+		if net.ParseIP(value) != nil {
+			return fmt.Errorf("must be a hostname, not an IP address")
+		}
+		if !dnsHostname.MatchString(value) {
+			return fmt.Errorf("must be a fully qualified hostname, e.g. mail.example.com")
+		}
+	case "SRV":
+		// "." means the service is explicitly not available.
+		if value != "." && !dnsHostname.MatchString(value) {
+			return fmt.Errorf("must be the fully qualified hostname of the target, e.g. sip.example.com, or \".\"")
+		}
+	case "TXT":
+		if value == "" {
+			return fmt.Errorf("must not be empty")
+		}
+		if strings.ContainsAny(value, "\r\n") {
+			return fmt.Errorf("must not contain line breaks")
+		}
+		if len(value) > 4096 {
+			return fmt.Errorf("must be at most 4096 characters")
+		}
+	case "CAA":
+		m := dnsCAAValue.FindStringSubmatch(value)
+		if m == nil {
+			return fmt.Errorf("must be flags, tag and quoted value, e.g. 0 issue \"letsencrypt.org\"")
+		}
+		if flags, _ := strconv.Atoi(m[1]); flags > 255 {
+			return fmt.Errorf("flags must be between 0 and 255")
+		}
+	}
+	return nil
+}
+
+func (r *DNSRecordResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DNSRecordResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating DNS record", map[string]interface{}{
+		"zone_id": data.ZoneID.ValueString(),
+		"name":    data.Name.ValueString(),
+		"type":    data.Type.ValueString(),
+	})
+
+	record, err := r.client.CreateDNSRecord(ctx, data.ZoneID.ValueString(), expandDNSRecord(&data))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create DNS record", err.Error())
+		return
+	}
+
+	mapDNSRecordToState(record, &data)
+
+	tflog.Info(ctx, "DNS record created", map[string]interface{}{
+		"id":   record.ID,
+		"fqdn": record.FQDN,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DNSRecordResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DNSRecordResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	record, err := r.client.GetDNSRecord(ctx, data.ZoneID.ValueString(), data.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Failed to read DNS record", err.Error())
+		return
+	}
+
+	mapDNSRecordToState(record, &data)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DNSRecordResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data DNSRecordResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating DNS record", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	record, err := r.client.UpdateDNSRecord(ctx, data.ZoneID.ValueString(), data.ID.ValueString(), expandDNSRecord(&data))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update DNS record", err.Error())
+		return
+	}
+
+	mapDNSRecordToState(record, &data)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DNSRecordResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data DNSRecordResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting DNS record", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	if err := r.client.DeleteDNSRecord(ctx, data.ZoneID.ValueString(), data.ID.ValueString()); err != nil {
+		if client.IsNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError("Failed to delete DNS record", err.Error())
+		return
+	}
+}
+
+// ImportState imports a record using "{zone_id}/{record_id}".
+func (r *DNSRecordResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	zoneID, recordID, ok := strings.Cut(req.ID, "/")
+	if !ok || zoneID == "" || recordID == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected import ID in the format {zone_id}/{record_id}, got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("zone_id"), zoneID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), recordID)...)
+}
+
+func expandDNSRecord(data *DNSRecordResourceModel) client.DNSRecordRequest {
+	return client.DNSRecordRequest{
+		Name:     data.Name.ValueString(),
+		Type:     data.Type.ValueString(),
+		Value:    data.Value.ValueString(),
+		TTL:      int(data.TTL.ValueInt64()),
+		Priority: intPointer(data.Priority),
+		Weight:   intPointer(data.Weight),
+		Port:     intPointer(data.Port),
+	}
+}
+
+// intPointer converts an optional Int64 attribute into an optional API field.
+func intPointer(v types.Int64) *int {
+	if v.IsNull() || v.IsUnknown() {
+		return nil
+	}
+	i := int(v.ValueInt64())
+	return &i
+}
+
+// int64FromPointer converts an optional API field into an Int64 attribute.
+func int64FromPointer(v *int) types.Int64 {
+	if v == nil {
+		return types.Int64Null()
+	}
+	return types.Int64Value(int64(*v))
+}
+
+func mapDNSRecordToState(record *client.DNSRecord, data *DNSRecordResourceModel) {
+	data.ID = types.StringValue(record.ID)
+	data.ZoneID = types.StringValue(record.ZoneID)
+	data.Name = types.StringValue(record.Name)
+	data.Type = types.StringValue(record.Type)
+	data.TTL = types.Int64Value(int64(record.TTL))
+	data.Priority = int64FromPointer(record.Priority)
+	data.Weight = int64FromPointer(record.Weight)
+	data.Port = int64FromPointer(record.Port)
+	data.FQDN = types.StringValue(record.FQDN)
+	data.CreatedAt = types.StringValue(record.CreatedAt)
+	data.UpdatedAt = types.StringValue(record.UpdatedAt)
+
+	// The API stores hostnames fully qualified, with a trailing dot. Keep the
+	// configured spelling when it only differs by that dot.
+	prior := data.Value.ValueString()
+	if data.Value.IsNull() || data.Value.IsUnknown() || strings.TrimSuffix(prior, ".") != strings.TrimSuffix(record.Value, ".") {
+		data.Value = types.StringValue(record.Value)
+	}
+}
@@ -0,0 +1,125 @@
+package resources
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/AdrianSilaghi/terraform-provider-danubedata/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestValidateDNSRecordValue(t *testing.T) {
+	tests := []struct {
+		recordType string
+		value      string
+		wantErr    string
+	}{
+		{recordType: "A", value: "203.0.113.7"},
+		{recordType: "A", value: "2001:db8::7", wantErr: "must be an IPv4 address"},
+		{recordType: "A", value: "www.example.com", wantErr: "must be an IPv4 address"},
+		{recordType: "AAAA", value: "2001:db8::7"},
+		{recordType: "AAAA", value: "203.0.113.7", wantErr: "must be an IPv6 address"},
+		{recordType: "CNAME", value: "my-site.pages.danubedata.ro"},
+		{recordType: "CNAME", value: "my-site.pages.danubedata.ro."},
+		{recordType: "CNAME", value: "203.0.113.7", wantErr: "not an IP address"},
+		{recordType: "CNAME", value: "localhost", wantErr: "fully qualified hostname"},
+		{recordType: "MX", value: "mail.example.com"},
+		{recordType: "SRV", value: "sip.example.com"},
+		{recordType: "SRV", value: "."},
+		{recordType: "TXT", value: "v=spf1 include:_spf.example.com ~all"},
+		{recordType: "TXT", value: "", wantErr: "must not be empty"},
+		{recordType: "TXT", value: "line\nbreak", wantErr: "line breaks"},
+		{recordType: "TXT", value: strings.Repeat("a", 4097), wantErr: "at most 4096"},
+		{recordType: "CAA", value: `0 issue "letsencrypt.org"`},
+		{recordType: "CAA", value: `128 iodef "mailto:security@example.com"`},
+		{recordType: "CAA", value: `0 issue letsencrypt.org`, wantErr: "flags, tag and quoted value"},
+		{recordType: "CAA", value: `256 issue "letsencrypt.org"`, wantErr: "flags must be between 0 and 255"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.recordType+" "+tt.value, func(t *testing.T) {
+			err := validateDNSRecordValue(tt.recordType, tt.value)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("error = %v, want it to contain %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateDNSRecord(t *testing.T) {
+	record := func(recordType, name, value string, priority, weight, port types.Int64) *DNSRecordResourceModel {
+		return &DNSRecordResourceModel{
+			Type:     types.StringValue(recordType),
+			Name:     types.StringValue(name),
+			Value:    types.StringValue(value),
+			Priority: priority,
+			Weight:   weight,
+			Port:     port,
+		}
+	}
+	null := types.Int64Null()
+	ten := types.Int64Value(10)
+
+	tests := []struct {
+		name    string
+		data    *DNSRecordResourceModel
+		wantErr string
+	}{
+		{name: "A", data: record("A", "www", "203.0.113.7", null, null, null)},
+		{name: "MX", data: record("MX", "@", "mail.example.com", ten, null, null)},
+		{name: "SRV", data: record("SRV", "_sip._tcp", "sip.example.com", ten, ten, types.Int64Value(5060))},
+		{name: "unknown value", data: &DNSRecordResourceModel{Type: types.StringValue("A"), Name: types.StringValue("www"), Value: types.StringUnknown(), Priority: null, Weight: null, Port: null}},
+		{name: "MX without priority", data: record("MX", "@", "mail.example.com", null, null, null), wantErr: "Missing DNS Record Attribute"},
+		{name: "A with priority", data: record("A", "www", "203.0.113.7", ten, null, null), wantErr: "Invalid DNS Record Attribute"},
+		{name: "MX with port", data: record("MX", "@", "mail.example.com", ten, null, ten), wantErr: "Invalid DNS Record Attribute"},
+		{name: "SRV without port", data: record("SRV", "_sip._tcp", "sip.example.com", ten, ten, null), wantErr: "Missing DNS Record Attribute"},
+		{name: "SRV name", data: record("SRV", "sip", "sip.example.com", ten, ten, ten), wantErr: "Invalid DNS Record Name"},
+		{name: "CNAME at apex", data: record("CNAME", "@", "example.net", null, null, null), wantErr: "Invalid DNS Record Name"},
+		{name: "bad value", data: record("AAAA", "www", "203.0.113.7", null, null, null), wantErr: "Invalid DNS Record Value"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			validateDNSRecord(tt.data, &diags)
+			if tt.wantErr == "" {
+				if diags.HasError() {
+					t.Errorf("unexpected errors: %v", diags)
+				}
+				return
+			}
+			if !diags.HasError() || diags.Errors()[0].Summary() != tt.wantErr {
+				t.Errorf("errors = %v, want %q", diags, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestMapDNSRecordToState_KeepsValueWithoutTrailingDot(t *testing.T) {
+	priority := 10
+	data := DNSRecordResourceModel{Value: types.StringValue("mail.example.com")}
+
+	mapDNSRecordToState(&client.DNSRecord{
+		ID: "rec-1", ZoneID: "zone-123", Name: "@", Type: "MX",
+		Value: "mail.example.com.", TTL: 3600, Priority: &priority,
+	}, &data)
+
+	if data.Value.ValueString() != "mail.example.com" {
+		t.Errorf("Value = %q, want the configured spelling", data.Value.ValueString())
+	}
+	if data.Priority.ValueInt64() != 10 || !data.Weight.IsNull() || !data.Port.IsNull() {
+		t.Errorf("Priority/Weight/Port = %v/%v/%v, want 10/null/null", data.Priority, data.Weight, data.Port)
+	}
+
+	mapDNSRecordToState(&client.DNSRecord{ID: "rec-1", Type: "MX", Value: "mx.example.net."}, &data)
+	if data.Value.ValueString() != "mx.example.net." {
+		t.Errorf("Value = %q, want the changed value from the API", data.Value.ValueString())
+	}
+}
@@ -0,0 +1,214 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/AdrianSilaghi/terraform-provider-danubedata/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &DNSZoneResource{}
+	_ resource.ResourceWithConfigure   = &DNSZoneResource{}
+	_ resource.ResourceWithImportState = &DNSZoneResource{}
+)
+
+// dnsZoneName matches a lowercase domain name without a trailing dot, e.g.
+// "example.com" or "staging.example.co.uk".
+var dnsZoneName = regexp.MustCompile(`^([a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?\.)+[a-z][a-z0-9-]{0,61}[a-z0-9]$`)
+
+type DNSZoneResource struct {
+	client *client.Client
+}
+
+type DNSZoneResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Status      types.String `tfsdk:"status"`
+	Nameservers types.List   `tfsdk:"nameservers"`
+	CreatedAt   types.String `tfsdk:"created_at"`
+	UpdatedAt   types.String `tfsdk:"updated_at"`
+}
+
+func NewDNSZoneResource() resource.Resource {
+	return &DNSZoneResource{}
+}
+
+func (r *DNSZoneResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns_zone"
+}
+
+func (r *DNSZoneResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a DNS zone hosted on DanubeData's nameservers. Records are managed with danubedata_dns_record. The zone only answers queries once the domain's registrar delegates to the zone's nameservers.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Unique identifier for the DNS zone.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Domain name of the zone, lowercase and without a trailing dot, e.g. 'example.com'. Changing this forces a new resource.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(253),
+					stringvalidator.RegexMatches(dnsZoneName, "must be a lowercase domain name without a trailing dot, e.g. example.com"),
+				},
+			},
+			"status": schema.StringAttribute{
+				Description: "Delegation status of the zone: 'pending' until the registrar delegates to the nameservers, then 'active'.",
+				Computed:    true,
+			},
+			"nameservers": schema.ListAttribute{
+				Description: "Nameservers to configure at the domain's registrar.",
+				Computed:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Description: "Timestamp when the zone was created.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				Description: "Timestamp when the zone was last updated.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *DNSZoneResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = c
+}
+
+func (r *DNSZoneResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DNSZoneResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating DNS zone", map[string]interface{}{
+		"name": data.Name.ValueString(),
+	})
+
+	zone, err := r.client.CreateDNSZone(ctx, client.CreateDNSZoneRequest{
+		Name: data.Name.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create DNS zone", err.Error())
+		return
+	}
+
+	r.mapZoneToState(ctx, zone, &data, &resp.Diagnostics)
+
+	tflog.Info(ctx, "DNS zone created", map[string]interface{}{
+		"id":   zone.ID,
+		"name": zone.Name,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DNSZoneResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DNSZoneResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zone, err := r.client.GetDNSZone(ctx, data.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Failed to read DNS zone", err.Error())
+		return
+	}
+
+	r.mapZoneToState(ctx, zone, &data, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update is never called with a change: the only argument, name, forces
+// replacement.
+func (r *DNSZoneResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data DNSZoneResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DNSZoneResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data DNSZoneResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting DNS zone", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	if err := r.client.DeleteDNSZone(ctx, data.ID.ValueString()); err != nil {
+		if client.IsNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError("Failed to delete DNS zone", err.Error())
+		return
+	}
+}
+
+func (r *DNSZoneResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *DNSZoneResource) mapZoneToState(ctx context.Context, zone *client.DNSZone, data *DNSZoneResourceModel, diags *diag.Diagnostics) {
+	data.ID = types.StringValue(zone.ID)
+	data.Name = types.StringValue(zone.Name)
+	data.Status = types.StringValue(zone.Status)
+	data.CreatedAt = types.StringValue(zone.CreatedAt)
+	data.UpdatedAt = types.StringValue(zone.UpdatedAt)
+
+	nameservers, nsDiags := types.ListValueFrom(ctx, types.StringType, zone.Nameservers)
+	diags.Append(nsDiags...)
+	data.Nameservers = nameservers
+}
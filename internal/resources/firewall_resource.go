@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/AdrianSilaghi/terraform-provider-danubedata/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -19,9 +20,11 @@ import (
 )
 
 var (
-	_ resource.Resource                = &FirewallResource{}
-	_ resource.ResourceWithConfigure   = &FirewallResource{}
-	_ resource.ResourceWithImportState = &FirewallResource{}
+	_ resource.Resource                   = &FirewallResource{}
+	_ resource.ResourceWithConfigure      = &FirewallResource{}
+	_ resource.ResourceWithImportState    = &FirewallResource{}
+	_ resource.ResourceWithValidateConfig = &FirewallResource{}
+	_ resource.ResourceWithModifyPlan     = &FirewallResource{}
 )
 
 type FirewallResource struct {
@@ -29,13 +32,15 @@ type FirewallResource struct {
 }
 
 type FirewallResourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Description types.String `tfsdk:"description"`
-	Status      types.String `tfsdk:"status"`
-	Rules       types.List   `tfsdk:"rules"`
-	CreatedAt   types.String `tfsdk:"created_at"`
-	UpdatedAt   types.String `tfsdk:"updated_at"`
+	ID                    types.String `tfsdk:"id"`
+	Name                  types.String `tfsdk:"name"`
+	Description           types.String `tfsdk:"description"`
+	Status                types.String `tfsdk:"status"`
+	DefaultInboundPolicy  types.String `tfsdk:"default_inbound_policy"`
+	DefaultOutboundPolicy types.String `tfsdk:"default_outbound_policy"`
+	Rules                 types.List   `tfsdk:"rules"`
+	CreatedAt             types.String `tfsdk:"created_at"`
+	UpdatedAt             types.String `tfsdk:"updated_at"`
 }
 
 type FirewallRuleModel struct {
@@ -47,9 +52,26 @@ type FirewallRuleModel struct {
 	PortRangeStart types.Int64  `tfsdk:"port_range_start"`
 	PortRangeEnd   types.Int64  `tfsdk:"port_range_end"`
 	SourceIPs      types.List   `tfsdk:"source_ips"`
+	SourceIPSetIDs types.List   `tfsdk:"source_ip_set_ids"`
+	DestinationIPs types.List   `tfsdk:"destination_ips"`
 	Order          types.Int64  `tfsdk:"order"`
 }
 
+// firewallRuleAttrTypes describes the object type of an element of the rules attribute.
+var firewallRuleAttrTypes = map[string]attr.Type{
+	"id":                types.StringType,
+	"name":              types.StringType,
+	"action":            types.StringType,
+	"direction":         types.StringType,
+	"protocol":          types.StringType,
+	"port_range_start":  types.Int64Type,
+	"port_range_end":    types.Int64Type,
+	"source_ips":        types.ListType{ElemType: types.StringType},
+	"source_ip_set_ids": types.ListType{ElemType: types.StringType},
+	"destination_ips":   types.ListType{ElemType: types.StringType},
+	"order":             types.Int64Type,
+}
+
 func NewFirewallResource() resource.Resource {
 	return &FirewallResource{}
 }
@@ -81,6 +103,28 @@ func (r *FirewallResource) Schema(ctx context.Context, req resource.SchemaReques
 				Description: "Current status of the firewall (draft, active, deploying).",
 				Computed:    true,
 			},
+			"default_inbound_policy": schema.StringAttribute{
+				Description: "Action applied to inbound traffic that matches no rule: 'allow' or 'deny'. Defaults to the platform default when omitted.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("allow", "deny"),
+				},
+			},
+			"default_outbound_policy": schema.StringAttribute{
+				Description: "Action applied to outbound traffic that matches no rule: 'allow' or 'deny'. Defaults to the platform default when omitted.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("allow", "deny"),
+				},
+			},
 			"rules": schema.ListNestedAttribute{
 				Description: "List of firewall rules.",
 				Optional:    true,
@@ -124,9 +168,22 @@ func (r *FirewallResource) Schema(ctx context.Context, req resource.SchemaReques
 							Optional:    true,
 						},
 						"source_ips": schema.ListAttribute{
-							Description: "List of source IP addresses or CIDR blocks.",
+							Description: "List of source IP addresses or CIDR blocks. Matched against the sender of inbound traffic.",
+							Optional:    true,
+							ElementType: types.StringType,
+						},
+						"source_ip_set_ids": schema.ListAttribute{
+							Description: "IDs of danubedata_ip_set resources whose addresses are matched in addition to source_ips. Updating a referenced IP set redeploys this firewall.",
+							Optional:    true,
+							ElementType: types.StringType,
+						},
+						"destination_ips": schema.ListAttribute{
+							Description: "List of destination IP addresses or CIDR blocks. Only valid on outbound rules, where it restricts which remote addresses the instance may reach.",
 							Optional:    true,
 							ElementType: types.StringType,
+							Validators: []validator.List{
+								listvalidator.ValueStringsAre(ipOrCIDR()),
+							},
 						},
 						"order": schema.Int64Attribute{
 							Description: "Rule evaluation order (lower numbers are evaluated first).",
@@ -180,40 +237,17 @@ func (r *FirewallResource) Create(ctx context.Context, req resource.CreateReques
 		Description: data.Description.ValueString(),
 	}
 
-	// Convert rules
-	if !data.Rules.IsNull() && !data.Rules.IsUnknown() {
-		var rules []FirewallRuleModel
-		resp.Diagnostics.Append(data.Rules.ElementsAs(ctx, &rules, false)...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-
-		createReq.Rules = make([]client.CreateFirewallRuleRequest, len(rules))
-		for i, rule := range rules {
-			ruleReq := client.CreateFirewallRuleRequest{
-				Name:      rule.Name.ValueString(),
-				Action:    rule.Action.ValueString(),
-				Direction: rule.Direction.ValueString(),
-				Protocol:  rule.Protocol.ValueString(),
-				Order:     int(rule.Order.ValueInt64()),
-			}
+	if !data.DefaultInboundPolicy.IsNull() && !data.DefaultInboundPolicy.IsUnknown() {
+		createReq.DefaultInboundPolicy = data.DefaultInboundPolicy.ValueString()
+	}
 
-			if !rule.PortRangeStart.IsNull() {
-				port := int(rule.PortRangeStart.ValueInt64())
-				ruleReq.PortRangeStart = &port
-			}
-			if !rule.PortRangeEnd.IsNull() {
-				port := int(rule.PortRangeEnd.ValueInt64())
-				ruleReq.PortRangeEnd = &port
-			}
-			if !rule.SourceIPs.IsNull() {
-				var sourceIPs []string
-				resp.Diagnostics.Append(rule.SourceIPs.ElementsAs(ctx, &sourceIPs, false)...)
-				ruleReq.SourceIPs = sourceIPs
-			}
+	if !data.DefaultOutboundPolicy.IsNull() && !data.DefaultOutboundPolicy.IsUnknown() {
+		createReq.DefaultOutboundPolicy = data.DefaultOutboundPolicy.ValueString()
+	}
 
-			createReq.Rules[i] = ruleReq
-		}
+	createReq.Rules = expandFirewallRules(ctx, data.Rules, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	firewall, err := r.client.CreateFirewall(ctx, createReq)
@@ -270,40 +304,17 @@ func (r *FirewallResource) Update(ctx context.Context, req resource.UpdateReques
 		Description: data.Description.ValueString(),
 	}
 
-	// Convert rules
-	if !data.Rules.IsNull() && !data.Rules.IsUnknown() {
-		var rules []FirewallRuleModel
-		resp.Diagnostics.Append(data.Rules.ElementsAs(ctx, &rules, false)...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-
-		updateReq.Rules = make([]client.CreateFirewallRuleRequest, len(rules))
-		for i, rule := range rules {
-			ruleReq := client.CreateFirewallRuleRequest{
-				Name:      rule.Name.ValueString(),
-				Action:    rule.Action.ValueString(),
-				Direction: rule.Direction.ValueString(),
-				Protocol:  rule.Protocol.ValueString(),
-				Order:     int(rule.Order.ValueInt64()),
-			}
+	if !data.DefaultInboundPolicy.IsNull() && !data.DefaultInboundPolicy.IsUnknown() {
+		updateReq.DefaultInboundPolicy = data.DefaultInboundPolicy.ValueString()
+	}
 
-			if !rule.PortRangeStart.IsNull() {
-				port := int(rule.PortRangeStart.ValueInt64())
-				ruleReq.PortRangeStart = &port
-			}
-			if !rule.PortRangeEnd.IsNull() {
-				port := int(rule.PortRangeEnd.ValueInt64())
-				ruleReq.PortRangeEnd = &port
-			}
-			if !rule.SourceIPs.IsNull() {
-				var sourceIPs []string
-				resp.Diagnostics.Append(rule.SourceIPs.ElementsAs(ctx, &sourceIPs, false)...)
-				ruleReq.SourceIPs = sourceIPs
-			}
+	if !data.DefaultOutboundPolicy.IsNull() && !data.DefaultOutboundPolicy.IsUnknown() {
+		updateReq.DefaultOutboundPolicy = data.DefaultOutboundPolicy.ValueString()
+	}
 
-			updateReq.Rules[i] = ruleReq
-		}
+	updateReq.Rules = expandFirewallRules(ctx, data.Rules, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	firewall, err := r.client.UpdateFirewall(ctx, data.ID.ValueString(), updateReq)
@@ -338,6 +349,80 @@ func (r *FirewallResource) Delete(ctx context.Context, req resource.DeleteReques
 	}
 }
 
+func (r *FirewallResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data FirewallResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || data.Rules.IsNull() || data.Rules.IsUnknown() {
+		return
+	}
+
+	var rules []FirewallRuleModel
+	resp.Diagnostics.Append(data.Rules.ElementsAs(ctx, &rules, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for i, rule := range rules {
+		if rule.Direction.IsUnknown() || rule.Direction.ValueString() != "inbound" {
+			continue
+		}
+		if !rule.DestinationIPs.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("rules").AtListIndex(i).AtName("destination_ips"),
+				"Destination IPs on Inbound Rule",
+				"destination_ips only applies to outbound rules. Use source_ips or source_ip_set_ids to restrict who may reach the instance.",
+			)
+		}
+	}
+}
+
+// ModifyPlan warns when a default-deny inbound policy would lock SSH out of an
+// attached VPS. Attachments are managed outside this resource, so they are
+// looked up from the API and only checked for firewalls that already exist.
+func (r *FirewallResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan FirewallResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.DefaultInboundPolicy.IsUnknown() || plan.DefaultInboundPolicy.ValueString() != "deny" || plan.Rules.IsUnknown() {
+		return
+	}
+
+	rules := expandFirewallRules(ctx, plan.Rules, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() || firewallRulesAllowInbound(rules, 22) {
+		return
+	}
+
+	firewall, err := r.client.GetFirewall(ctx, plan.ID.ValueString())
+	if err != nil {
+		tflog.Debug(ctx, "Could not look up firewall attachments for SSH check", map[string]interface{}{
+			"id":    plan.ID.ValueString(),
+			"error": err.Error(),
+		})
+		return
+	}
+
+	for _, attachment := range firewall.Attachments {
+		if attachment.InstanceType != "vps" {
+			continue
+		}
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("default_inbound_policy"),
+			"Firewall Blocks SSH to Attached VPS",
+			fmt.Sprintf("Firewall %s denies unmatched inbound traffic and no rule allows TCP port 22, "+
+				"so SSH to attached VPS %s will be blocked after this apply. "+
+				"Add an inbound allow rule for port 22, ahead of any deny rule covering it, if you still need shell access.",
+				plan.ID.ValueString(), attachment.InstanceID),
+		)
+	}
+}
+
 func (r *FirewallResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
@@ -347,6 +432,8 @@ func (r *FirewallResource) mapFirewallToState(ctx context.Context, firewall *cli
 	data.Name = types.StringValue(firewall.Name)
 	data.Description = types.StringValue(firewall.Description)
 	data.Status = types.StringValue(firewall.Status)
+	data.DefaultInboundPolicy = stringOrPrior(firewall.DefaultInboundPolicy, data.DefaultInboundPolicy)
+	data.DefaultOutboundPolicy = stringOrPrior(firewall.DefaultOutboundPolicy, data.DefaultOutboundPolicy)
 	data.CreatedAt = types.StringValue(firewall.CreatedAt)
 	data.UpdatedAt = types.StringValue(firewall.UpdatedAt)
 
@@ -360,6 +447,11 @@ func (r *FirewallResource) mapFirewallToState(ctx context.Context, firewall *cli
 			}
 			sourceIPsList, _ := types.ListValue(types.StringType, sourceIPsValues)
 
+			// Rules that reference no IP set or destination keep the attribute
+			// null so that configurations omitting it do not see a perpetual diff.
+			ipSetIDsList := stringListOrNull(rule.SourceIPSetIDs)
+			destinationIPsList := stringListOrNull(rule.DestinationIPs)
+
 			var portStart, portEnd types.Int64
 			if rule.PortRangeStart != nil {
 				portStart = types.Int64Value(int64(*rule.PortRangeStart))
@@ -373,63 +465,110 @@ func (r *FirewallResource) mapFirewallToState(ctx context.Context, firewall *cli
 			}
 
 			ruleObj, _ := types.ObjectValue(
-				map[string]attr.Type{
-					"id":               types.StringType,
-					"name":             types.StringType,
-					"action":           types.StringType,
-					"direction":        types.StringType,
-					"protocol":         types.StringType,
-					"port_range_start": types.Int64Type,
-					"port_range_end":   types.Int64Type,
-					"source_ips":       types.ListType{ElemType: types.StringType},
-					"order":            types.Int64Type,
-				},
+				firewallRuleAttrTypes,
 				map[string]attr.Value{
-					"id":               types.StringValue(rule.ID),
-					"name":             types.StringValue(rule.Name),
-					"action":           types.StringValue(rule.Action),
-					"direction":        types.StringValue(rule.Direction),
-					"protocol":         types.StringValue(rule.Protocol),
-					"port_range_start": portStart,
-					"port_range_end":   portEnd,
-					"source_ips":       sourceIPsList,
-					"order":            types.Int64Value(int64(rule.Order)),
+					"id":                types.StringValue(rule.ID),
+					"name":              types.StringValue(rule.Name),
+					"action":            types.StringValue(rule.Action),
+					"direction":         types.StringValue(rule.Direction),
+					"protocol":          types.StringValue(rule.Protocol),
+					"port_range_start":  portStart,
+					"port_range_end":    portEnd,
+					"source_ips":        sourceIPsList,
+					"source_ip_set_ids": ipSetIDsList,
+					"destination_ips":   destinationIPsList,
+					"order":             types.Int64Value(int64(rule.Order)),
 				},
 			)
 			ruleObjects[i] = ruleObj
 		}
 
 		rulesList, diagsRules := types.ListValue(
-			types.ObjectType{
-				AttrTypes: map[string]attr.Type{
-					"id":               types.StringType,
-					"name":             types.StringType,
-					"action":           types.StringType,
-					"direction":        types.StringType,
-					"protocol":         types.StringType,
-					"port_range_start": types.Int64Type,
-					"port_range_end":   types.Int64Type,
-					"source_ips":       types.ListType{ElemType: types.StringType},
-					"order":            types.Int64Type,
-				},
-			},
+			types.ObjectType{AttrTypes: firewallRuleAttrTypes},
 			ruleObjects,
 		)
 		diags.Append(diagsRules...)
 		data.Rules = rulesList
 	} else {
-		data.Rules = types.ListNull(types.ObjectType{
-			AttrTypes: map[string]attr.Type{
-				"id":               types.StringType,
-				"name":             types.StringType,
-				"action":           types.StringType,
-				"direction":        types.StringType,
-				"protocol":         types.StringType,
-				"port_range_start": types.Int64Type,
-				"port_range_end":   types.Int64Type,
-				"source_ips":       types.ListType{ElemType: types.StringType},
-				"order":            types.Int64Type,
-			},
-		})
+		data.Rules = types.ListNull(types.ObjectType{AttrTypes: firewallRuleAttrTypes})
+	}
+}
+
+// expandFirewallRules converts the rules attribute into API rule requests. A null or
+// unknown list yields no rules.
+func expandFirewallRules(ctx context.Context, rulesList types.List, diags *diag.Diagnostics) []client.CreateFirewallRuleRequest {
+	if rulesList.IsNull() || rulesList.IsUnknown() {
+		return nil
+	}
+
+	var rules []FirewallRuleModel
+	diags.Append(rulesList.ElementsAs(ctx, &rules, false)...)
+	if diags.HasError() {
+		return nil
+	}
+
+	ruleReqs := make([]client.CreateFirewallRuleRequest, len(rules))
+	for i, rule := range rules {
+		ruleReq := client.CreateFirewallRuleRequest{
+			Name:      rule.Name.ValueString(),
+			Action:    rule.Action.ValueString(),
+			Direction: rule.Direction.ValueString(),
+			Protocol:  rule.Protocol.ValueString(),
+			Order:     int(rule.Order.ValueInt64()),
+		}
+
+		if !rule.PortRangeStart.IsNull() {
+			port := int(rule.PortRangeStart.ValueInt64())
+			ruleReq.PortRangeStart = &port
+		}
+		if !rule.PortRangeEnd.IsNull() {
+			port := int(rule.PortRangeEnd.ValueInt64())
+			ruleReq.PortRangeEnd = &port
+		}
+		if !rule.SourceIPs.IsNull() {
+			var sourceIPs []string
+			diags.Append(rule.SourceIPs.ElementsAs(ctx, &sourceIPs, false)...)
+			ruleReq.SourceIPs = sourceIPs
+		}
+		if !rule.SourceIPSetIDs.IsNull() && !rule.SourceIPSetIDs.IsUnknown() {
+			var ipSetIDs []string
+			diags.Append(rule.SourceIPSetIDs.ElementsAs(ctx, &ipSetIDs, false)...)
+			ruleReq.SourceIPSetIDs = ipSetIDs
+		}
+		if !rule.DestinationIPs.IsNull() && !rule.DestinationIPs.IsUnknown() {
+			var destinationIPs []string
+			diags.Append(rule.DestinationIPs.ElementsAs(ctx, &destinationIPs, false)...)
+			ruleReq.DestinationIPs = destinationIPs
+		}
+
+		ruleReqs[i] = ruleReq
+	}
+	return ruleReqs
+}
+
+// firewallRulesAllowInbound reports whether inbound TCP traffic to the given
+// port is allowed by a rule. Rules are evaluated in list order, which is the
+// order the API numbers them in, and the first inbound rule that matches the
+// port decides, whether it allows or denies.
+func firewallRulesAllowInbound(rules []client.CreateFirewallRuleRequest, port int) bool {
+	for _, rule := range rules {
+		if rule.Direction != "inbound" {
+			continue
+		}
+		if rule.Protocol != "tcp" && rule.Protocol != "any" {
+			continue
+		}
+		// A rule without a port range matches every port.
+		if rule.PortRangeStart != nil {
+			end := *rule.PortRangeStart
+			if rule.PortRangeEnd != nil {
+				end = *rule.PortRangeEnd
+			}
+			if port < *rule.PortRangeStart || port > end {
+				continue
+			}
+		}
+		return rule.Action == "allow"
 	}
+	return false
 }
@@ -0,0 +1,134 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/AdrianSilaghi/terraform-provider-danubedata/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestFirewallRulesAllowInbound(t *testing.T) {
+	port := func(p int) *int { return &p }
+
+	tests := []struct {
+		name  string
+		rules []client.CreateFirewallRuleRequest
+		want  bool
+	}{
+		{name: "no rules", rules: nil, want: false},
+		{
+			name:  "exact ssh rule",
+			rules: []client.CreateFirewallRuleRequest{{Action: "allow", Direction: "inbound", Protocol: "tcp", PortRangeStart: port(22), PortRangeEnd: port(22)}},
+			want:  true,
+		},
+		{
+			name:  "range covering 22",
+			rules: []client.CreateFirewallRuleRequest{{Action: "allow", Direction: "inbound", Protocol: "tcp", PortRangeStart: port(20), PortRangeEnd: port(25)}},
+			want:  true,
+		},
+		{
+			name:  "single port without end",
+			rules: []client.CreateFirewallRuleRequest{{Action: "allow", Direction: "inbound", Protocol: "tcp", PortRangeStart: port(22)}},
+			want:  true,
+		},
+		{
+			name:  "any protocol all ports",
+			rules: []client.CreateFirewallRuleRequest{{Action: "allow", Direction: "inbound", Protocol: "any"}},
+			want:  true,
+		},
+		{
+			name:  "other port",
+			rules: []client.CreateFirewallRuleRequest{{Action: "allow", Direction: "inbound", Protocol: "tcp", PortRangeStart: port(443), PortRangeEnd: port(443)}},
+			want:  false,
+		},
+		{
+			name:  "udp only",
+			rules: []client.CreateFirewallRuleRequest{{Action: "allow", Direction: "inbound", Protocol: "udp", PortRangeStart: port(22), PortRangeEnd: port(22)}},
+			want:  false,
+		},
+		{
+			name:  "deny rule",
+			rules: []client.CreateFirewallRuleRequest{{Action: "deny", Direction: "inbound", Protocol: "tcp", PortRangeStart: port(22), PortRangeEnd: port(22)}},
+			want:  false,
+		},
+		{
+			name: "earlier deny wins over later allow",
+			rules: []client.CreateFirewallRuleRequest{
+				{Action: "deny", Direction: "inbound", Protocol: "tcp", PortRangeStart: port(1), PortRangeEnd: port(1024)},
+				{Action: "allow", Direction: "inbound", Protocol: "tcp", PortRangeStart: port(22), PortRangeEnd: port(22)},
+			},
+			want: false,
+		},
+		{
+			name: "earlier allow wins over later deny",
+			rules: []client.CreateFirewallRuleRequest{
+				{Action: "allow", Direction: "inbound", Protocol: "tcp", PortRangeStart: port(22), PortRangeEnd: port(22)},
+				{Action: "deny", Direction: "inbound", Protocol: "any"},
+			},
+			want: true,
+		},
+		{
+			name: "deny on another port does not match",
+			rules: []client.CreateFirewallRuleRequest{
+				{Action: "deny", Direction: "inbound", Protocol: "tcp", PortRangeStart: port(3306), PortRangeEnd: port(3306)},
+				{Action: "allow", Direction: "inbound", Protocol: "tcp", PortRangeStart: port(22), PortRangeEnd: port(22)},
+			},
+			want: true,
+		},
+		{
+			name:  "outbound rule",
+			rules: []client.CreateFirewallRuleRequest{{Action: "allow", Direction: "outbound", Protocol: "tcp", PortRangeStart: port(22), PortRangeEnd: port(22)}},
+			want:  false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := firewallRulesAllowInbound(tt.rules, 22); got != tt.want {
+				t.Errorf("firewallRulesAllowInbound() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStringListOrNull(t *testing.T) {
+	if got := stringListOrNull(nil); !got.IsNull() {
+		t.Errorf("stringListOrNull(nil) = %v, want null", got)
+	}
+	if got := stringListOrNull([]string{}); !got.IsNull() {
+		t.Errorf("stringListOrNull([]) = %v, want null", got)
+	}
+	got := stringListOrNull([]string{"10.0.0.0/8"})
+	if got.IsNull() || len(got.Elements()) != 1 {
+		t.Errorf("stringListOrNull([10.0.0.0/8]) = %v, want one element", got)
+	}
+}
+
+func TestMapFirewallToState_DefaultPolicies(t *testing.T) {
+	r := &FirewallResource{}
+	var diags diag.Diagnostics
+
+	// A policy the API leaves out keeps the configured value.
+	data := FirewallResourceModel{
+		DefaultInboundPolicy:  types.StringValue("deny"),
+		DefaultOutboundPolicy: types.StringUnknown(),
+	}
+	r.mapFirewallToState(context.Background(), &client.Firewall{ID: "fw-1"}, &data, &diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected errors: %v", diags)
+	}
+	if data.DefaultInboundPolicy.ValueString() != "deny" {
+		t.Errorf("DefaultInboundPolicy = %v, want the configured deny", data.DefaultInboundPolicy)
+	}
+	if !data.DefaultOutboundPolicy.IsNull() {
+		t.Errorf("DefaultOutboundPolicy = %v, want null", data.DefaultOutboundPolicy)
+	}
+
+	// A policy the API returns wins.
+	r.mapFirewallToState(context.Background(), &client.Firewall{ID: "fw-1", DefaultInboundPolicy: "allow"}, &data, &diags)
+	if data.DefaultInboundPolicy.ValueString() != "allow" {
+		t.Errorf("DefaultInboundPolicy = %v, want allow", data.DefaultInboundPolicy)
+	}
+}
@@ -0,0 +1,50 @@
+package resources
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// stringOrNull converts a string to a string value, mapping an empty string to null.
+func stringOrNull(value string) types.String {
+	if value == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
+
+// stringOrPrior converts a string to a string value. An empty string, which
+// the API returns for fields it leaves out, keeps a known prior value and
+// otherwise maps to null.
+func stringOrPrior(value string, prior types.String) types.String {
+	if value == "" && !prior.IsUnknown() {
+		return prior
+	}
+	return stringOrNull(value)
+}
+
+// stringListOrNull converts a string slice to a list value, mapping an empty slice to null.
+func stringListOrNull(values []string) types.List {
+	if len(values) == 0 {
+		return types.ListNull(types.StringType)
+	}
+	elems := make([]attr.Value, len(values))
+	for i, v := range values {
+		elems[i] = types.StringValue(v)
+	}
+	list, _ := types.ListValue(types.StringType, elems)
+	return list
+}
+
+// addDnsStateWarning reports a failure to read the live DNS state during an
+// apply. The apply itself succeeded, so this is a warning rather than an
+// error; the next refresh reports the failure if it persists.
+func addDnsStateWarning(diags *diag.Diagnostics, kind string, err error) {
+	diags.AddWarning(
+		fmt.Sprintf("Failed to read %s DNS state", kind),
+		fmt.Sprintf("%s. dns_enabled keeps its planned value until the next refresh.", err),
+	)
+}
@@ -0,0 +1,301 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/AdrianSilaghi/terraform-provider-danubedata/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &IPSetResource{}
+	_ resource.ResourceWithConfigure   = &IPSetResource{}
+	_ resource.ResourceWithImportState = &IPSetResource{}
+)
+
+type IPSetResource struct {
+	client *client.Client
+}
+
+type IPSetResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	CIDRs       types.Set    `tfsdk:"cidrs"`
+	FirewallIDs types.Set    `tfsdk:"firewall_ids"`
+	CreatedAt   types.String `tfsdk:"created_at"`
+	UpdatedAt   types.String `tfsdk:"updated_at"`
+}
+
+func NewIPSetResource() resource.Resource {
+	return &IPSetResource{}
+}
+
+func (r *IPSetResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ip_set"
+}
+
+func (r *IPSetResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a reusable set of IP addresses and CIDR blocks (an address group) that firewall rules reference via `source_ip_set_ids`. Changing the set redeploys every firewall that uses it.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Unique identifier for the IP set.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the IP set.",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the IP set.",
+				Optional:    true,
+			},
+			"cidrs": schema.SetAttribute{
+				Description: "IP addresses or CIDR blocks in the set (e.g. 203.0.113.0/24, 2001:db8::/32).",
+				Required:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(ipOrCIDR()),
+				},
+			},
+			"firewall_ids": schema.SetAttribute{
+				Description: "IDs of the firewalls with at least one rule referencing this IP set.",
+				Computed:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Description: "Timestamp when the IP set was created.",
+				Computed:    true,
+			},
+			"updated_at": schema.StringAttribute{
+				Description: "Timestamp when the IP set was last updated.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *IPSetResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = c
+}
+
+func (r *IPSetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data IPSetResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating IP set", map[string]interface{}{
+		"name": data.Name.ValueString(),
+	})
+
+	var cidrs []string
+	resp.Diagnostics.Append(data.CIDRs.ElementsAs(ctx, &cidrs, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ipSet, err := r.client.CreateIPSet(ctx, client.CreateIPSetRequest{
+		Name:        data.Name.ValueString(),
+		Description: data.Description.ValueString(),
+		CIDRs:       cidrs,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create IP set", err.Error())
+		return
+	}
+
+	r.mapIPSetToState(ctx, ipSet, &data, &resp.Diagnostics)
+	// A new set cannot be referenced by any firewall yet.
+	data.FirewallIDs = types.SetValueMust(types.StringType, nil)
+
+	tflog.Info(ctx, "IP set created", map[string]interface{}{
+		"id":   ipSet.ID,
+		"name": ipSet.Name,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *IPSetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data IPSetResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ipSet, err := r.client.GetIPSet(ctx, data.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Failed to read IP set", err.Error())
+		return
+	}
+
+	r.mapIPSetToState(ctx, ipSet, &data, &resp.Diagnostics)
+	r.refreshFirewallIDs(ctx, ipSet.ID, &data, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *IPSetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data IPSetResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state IPSetResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating IP set", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	var cidrs []string
+	resp.Diagnostics.Append(data.CIDRs.ElementsAs(ctx, &cidrs, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Always sent, so that removing description clears it.
+	description := data.Description.ValueString()
+	ipSet, err := r.client.UpdateIPSet(ctx, data.ID.ValueString(), client.UpdateIPSetRequest{
+		Name:        data.Name.ValueString(),
+		Description: &description,
+		CIDRs:       cidrs,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update IP set", err.Error())
+		return
+	}
+
+	r.mapIPSetToState(ctx, ipSet, &data, &resp.Diagnostics)
+
+	// Save the new addresses before redeploying, so a failed redeploy does not
+	// lose them from state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only an address change alters what the firewalls enforce; a rename or
+	// description change needs no redeploy.
+	if !data.CIDRs.Equal(state.CIDRs) {
+		deployed, err := r.client.DeployFirewallsUsingIPSet(ctx, ipSet.ID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to redeploy firewalls using IP set",
+				fmt.Sprintf("IP set %s was updated, but not every firewall referencing it was redeployed: %s", ipSet.ID, err),
+			)
+			return
+		}
+
+		tflog.Info(ctx, "Redeployed firewalls using IP set", map[string]interface{}{
+			"id":           ipSet.ID,
+			"firewall_ids": deployed,
+		})
+	}
+
+	// firewall_ids keeps its planned value from state: updating the set does
+	// not change which firewalls reference it, and Read refreshes it.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *IPSetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data IPSetResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting IP set", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	err := r.client.DeleteIPSet(ctx, data.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError("Failed to delete IP set", err.Error())
+		return
+	}
+}
+
+func (r *IPSetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *IPSetResource) mapIPSetToState(ctx context.Context, ipSet *client.IPSet, data *IPSetResourceModel, diags *diag.Diagnostics) {
+	data.ID = types.StringValue(ipSet.ID)
+	data.Name = types.StringValue(ipSet.Name)
+	data.CreatedAt = types.StringValue(ipSet.CreatedAt)
+	data.UpdatedAt = types.StringValue(ipSet.UpdatedAt)
+
+	if ipSet.Description != "" {
+		data.Description = types.StringValue(ipSet.Description)
+	} else {
+		data.Description = types.StringNull()
+	}
+
+	cidrs, cidrDiags := types.SetValueFrom(ctx, types.StringType, ipSet.CIDRs)
+	diags.Append(cidrDiags...)
+	data.CIDRs = cidrs
+}
+
+// refreshFirewallIDs populates firewall_ids from the firewalls currently referencing the set.
+func (r *IPSetResource) refreshFirewallIDs(ctx context.Context, ipSetID string, data *IPSetResourceModel, diags *diag.Diagnostics) {
+	firewalls, err := r.client.ListFirewallsUsingIPSet(ctx, ipSetID)
+	if err != nil {
+		diags.AddError("Failed to list firewalls using IP set", err.Error())
+		return
+	}
+
+	ids := make([]string, len(firewalls))
+	for i, fw := range firewalls {
+		ids[i] = fw.ID
+	}
+
+	firewallIDs, idDiags := types.SetValueFrom(ctx, types.StringType, ids)
+	diags.Append(idDiags...)
+	data.FirewallIDs = firewallIDs
+}
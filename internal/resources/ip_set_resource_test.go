@@ -0,0 +1,102 @@
+package resources_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/AdrianSilaghi/terraform-provider-danubedata/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccIPSetResource_basic(t *testing.T) {
+	name := acctest.RandomName("tf-ips")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and read
+			{
+				Config: testAccIPSetResourceConfig_basic(name, `["203.0.113.0/24", "198.51.100.7"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("danubedata_ip_set.test", "name", name),
+					resource.TestCheckResourceAttr("danubedata_ip_set.test", "cidrs.#", "2"),
+					resource.TestCheckResourceAttrSet("danubedata_ip_set.test", "id"),
+				),
+			},
+			// Import
+			{
+				ResourceName:      "danubedata_ip_set.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update addresses
+			{
+				Config: testAccIPSetResourceConfig_basic(name, `["192.0.2.0/24"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("danubedata_ip_set.test", "cidrs.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIPSetResource_referencedByFirewall(t *testing.T) {
+	name := acctest.RandomName("tf-ips")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIPSetResourceConfig_withFirewall(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("danubedata_firewall.test", "rules.0.source_ip_set_ids.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"danubedata_firewall.test", "rules.0.source_ip_set_ids.0",
+						"danubedata_ip_set.test", "id",
+					),
+				),
+			},
+		},
+	})
+}
+
+func testAccIPSetResourceConfig_basic(name, cidrs string) string {
+	return acctest.ConfigCompose(
+		acctest.ProviderConfig(),
+		fmt.Sprintf(`
+resource "danubedata_ip_set" "test" {
+  name  = %q
+  cidrs = %s
+}
+`, name, cidrs),
+	)
+}
+
+func testAccIPSetResourceConfig_withFirewall(name string) string {
+	return acctest.ConfigCompose(
+		acctest.ProviderConfig(),
+		fmt.Sprintf(`
+resource "danubedata_ip_set" "test" {
+  name  = %[1]q
+  cidrs = ["203.0.113.0/24"]
+}
+
+resource "danubedata_firewall" "test" {
+  name = %[1]q
+
+  rules = [
+    {
+      action            = "allow"
+      direction         = "inbound"
+      protocol          = "tcp"
+      port_range_start  = 22
+      port_range_end    = 22
+      source_ip_set_ids = [danubedata_ip_set.test.id]
+    },
+  ]
+}
+`, name),
+	)
+}
@@ -0,0 +1,166 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/AdrianSilaghi/terraform-provider-danubedata/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// MaintenanceWindowModel is the maintenance_window attribute of
+// danubedata_database and danubedata_cache.
+type MaintenanceWindowModel struct {
+	DayOfWeek     types.String `tfsdk:"day_of_week"`
+	StartHour     types.Int64  `tfsdk:"start_hour"`
+	DurationHours types.Int64  `tfsdk:"duration_hours"`
+}
+
+// maintenanceWindowAttrTypes describes the object type of the maintenance_window attribute.
+var maintenanceWindowAttrTypes = map[string]attr.Type{
+	"day_of_week":    types.StringType,
+	"start_hour":     types.Int64Type,
+	"duration_hours": types.Int64Type,
+}
+
+// pendingChangesAttrTypes describes the object type of the pending_changes attribute.
+var pendingChangesAttrTypes = map[string]attr.Type{
+	"resource_profile":   types.StringType,
+	"parameter_group_id": types.StringType,
+	"scheduled_at":       types.StringType,
+}
+
+// maintenanceWindowAttribute returns the schema of the maintenance_window
+// attribute. kind names the instance in descriptions, e.g. "database".
+func maintenanceWindowAttribute(kind string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Description: fmt.Sprintf("Weekly window in which queued disruptive changes to the %s are applied. Defaults to the window the platform assigns.", kind),
+		Optional:    true,
+		Computed:    true,
+		PlanModifiers: []planmodifier.Object{
+			objectplanmodifier.UseStateForUnknown(),
+		},
+		Attributes: map[string]schema.Attribute{
+			"day_of_week": schema.StringAttribute{
+				Description: "Day the window starts on (monday to sunday).",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("monday", "tuesday", "wednesday", "thursday", "friday", "saturday", "sunday"),
+				},
+			},
+			"start_hour": schema.Int64Attribute{
+				Description: "Hour the window starts at, in UTC. Between 0 and 23.",
+				Required:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(0, 23),
+				},
+			},
+			"duration_hours": schema.Int64Attribute{
+				Description: "Length of the window in hours. Between 1 and 8; defaults to 1.",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(1),
+				Validators: []validator.Int64{
+					int64validator.Between(1, 8),
+				},
+			},
+		},
+	}
+}
+
+// applyImmediatelyAttribute returns the schema of the apply_immediately attribute.
+func applyImmediatelyAttribute(kind string) schema.BoolAttribute {
+	return schema.BoolAttribute{
+		Description: fmt.Sprintf("Apply resource_profile and parameter_group_id changes, which restart the %s, immediately. When false they are queued for maintenance_window and shown in pending_changes. Defaults to true.", kind),
+		Optional:    true,
+		Computed:    true,
+		Default:     booldefault.StaticBool(true),
+	}
+}
+
+// pendingChangesAttribute returns the schema of the pending_changes attribute.
+func pendingChangesAttribute() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Description: "Changes queued for the next maintenance window. resource_profile and parameter_group_id already show the queued values. Null when nothing is queued.",
+		Computed:    true,
+		Attributes: map[string]schema.Attribute{
+			"resource_profile": schema.StringAttribute{
+				Description: "Queued resource profile, or null if it is not changing.",
+				Computed:    true,
+			},
+			"parameter_group_id": schema.StringAttribute{
+				Description: "Queued parameter group ID, or null if it is not changing.",
+				Computed:    true,
+			},
+			"scheduled_at": schema.StringAttribute{
+				Description: "Start of the maintenance window the changes are applied in.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// expandMaintenanceWindow converts the maintenance_window attribute into its
+// API form. It returns nil when the attribute is null or unknown.
+func expandMaintenanceWindow(ctx context.Context, obj types.Object, diags *diag.Diagnostics) *client.MaintenanceWindow {
+	if obj.IsNull() || obj.IsUnknown() {
+		return nil
+	}
+
+	var window MaintenanceWindowModel
+	diags.Append(obj.As(ctx, &window, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return nil
+	}
+
+	return &client.MaintenanceWindow{
+		DayOfWeek:     window.DayOfWeek.ValueString(),
+		StartHour:     int(window.StartHour.ValueInt64()),
+		DurationHours: int(window.DurationHours.ValueInt64()),
+	}
+}
+
+func flattenMaintenanceWindow(window *client.MaintenanceWindow) types.Object {
+	if window == nil {
+		return types.ObjectNull(maintenanceWindowAttrTypes)
+	}
+	return types.ObjectValueMust(maintenanceWindowAttrTypes, map[string]attr.Value{
+		"day_of_week":    types.StringValue(window.DayOfWeek),
+		"start_hour":     types.Int64Value(int64(window.StartHour)),
+		"duration_hours": types.Int64Value(int64(window.DurationHours)),
+	})
+}
+
+func flattenPendingChanges(pending *client.PendingChanges) types.Object {
+	if pending == nil || (pending.ResourceProfile == nil && pending.ParameterGroupID == nil) {
+		return types.ObjectNull(pendingChangesAttrTypes)
+	}
+	return types.ObjectValueMust(pendingChangesAttrTypes, map[string]attr.Value{
+		"resource_profile":   types.StringPointerValue(pending.ResourceProfile),
+		"parameter_group_id": types.StringPointerValue(pending.ParameterGroupID),
+		"scheduled_at":       types.StringValue(pending.ScheduledAt),
+	})
+}
+
+// applyQueuedChanges reports whether an update also applies the changes still
+// queued for the maintenance window. That happens only when apply_immediately
+// is switched on, or when the profile or parameter group is changed again
+// with it set; other updates leave the queue alone, including changes queued
+// outside Terraform.
+func applyQueuedChanges(plan, state types.Bool, pending types.Object, reconfigured bool) bool {
+	if !plan.ValueBool() || pending.IsNull() {
+		return false
+	}
+	return !state.ValueBool() || reconfigured
+}
@@ -0,0 +1,88 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/AdrianSilaghi/terraform-provider-danubedata/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestMaintenanceWindowRoundTrip(t *testing.T) {
+	window := &client.MaintenanceWindow{DayOfWeek: "sunday", StartHour: 3, DurationHours: 2}
+
+	var diags diag.Diagnostics
+	got := expandMaintenanceWindow(context.Background(), flattenMaintenanceWindow(window), &diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected errors: %v", diags)
+	}
+	if got == nil || *got != *window {
+		t.Errorf("expandMaintenanceWindow() = %+v, want %+v", got, window)
+	}
+
+	if got := expandMaintenanceWindow(context.Background(), types.ObjectUnknown(maintenanceWindowAttrTypes), &diags); got != nil {
+		t.Errorf("expandMaintenanceWindow(unknown) = %+v, want nil", got)
+	}
+	if got := flattenMaintenanceWindow(nil); !got.IsNull() {
+		t.Errorf("flattenMaintenanceWindow(nil) = %v, want null", got)
+	}
+}
+
+func TestFlattenPendingChanges(t *testing.T) {
+	profile := "large"
+
+	tests := []struct {
+		name     string
+		pending  *client.PendingChanges
+		wantNull bool
+	}{
+		{name: "nil", pending: nil, wantNull: true},
+		{name: "nothing queued", pending: &client.PendingChanges{ScheduledAt: "2026-10-18T03:00:00Z"}, wantNull: true},
+		{name: "profile queued", pending: &client.PendingChanges{ResourceProfile: &profile, ScheduledAt: "2026-10-18T03:00:00Z"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := flattenPendingChanges(tt.pending)
+			if got.IsNull() != tt.wantNull {
+				t.Fatalf("flattenPendingChanges() = %v, want null %v", got, tt.wantNull)
+			}
+			if tt.wantNull {
+				return
+			}
+			if v := got.Attributes()["parameter_group_id"]; !v.IsNull() {
+				t.Errorf("parameter_group_id = %v, want null", v)
+			}
+		})
+	}
+}
+
+func TestApplyQueuedChanges(t *testing.T) {
+	profile := "large"
+	pending := flattenPendingChanges(&client.PendingChanges{ResourceProfile: &profile, ScheduledAt: "2026-10-18T03:00:00Z"})
+	nothing := flattenPendingChanges(nil)
+
+	tests := []struct {
+		name         string
+		plan, state  bool
+		pending      types.Object
+		reconfigured bool
+		want         bool
+	}{
+		{name: "switched on", plan: true, state: false, pending: pending, want: true},
+		{name: "unrelated update", plan: true, state: true, pending: pending},
+		{name: "profile changed again", plan: true, state: true, pending: pending, reconfigured: true, want: true},
+		{name: "still queued", plan: false, state: false, pending: pending, reconfigured: true},
+		{name: "nothing queued", plan: true, state: false, pending: nothing},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := applyQueuedChanges(types.BoolValue(tt.plan), types.BoolValue(tt.state), tt.pending, tt.reconfigured)
+			if got != tt.want {
+				t.Errorf("applyQueuedChanges() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
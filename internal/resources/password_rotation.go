@@ -0,0 +1,130 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// PasswordRotationModel is the password_rotation attribute of
+// danubedata_database and danubedata_cache.
+type PasswordRotationModel struct {
+	RotationTrigger    types.String `tfsdk:"rotation_trigger"`
+	RotateAfterDays    types.Int64  `tfsdk:"rotate_after_days"`
+	GracePeriodMinutes types.Int64  `tfsdk:"grace_period_minutes"`
+}
+
+// passwordRotationAttrTypes describes the object type of the password_rotation attribute.
+var passwordRotationAttrTypes = map[string]attr.Type{
+	"rotation_trigger":     types.StringType,
+	"rotate_after_days":    types.Int64Type,
+	"grace_period_minutes": types.Int64Type,
+}
+
+// passwordRotationAttribute returns the schema of the password_rotation
+// attribute. kind names the instance in descriptions, e.g. "database".
+func passwordRotationAttribute(kind string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Description: fmt.Sprintf("Rotates the password of the %s when rotation_trigger changes or rotate_after_days have passed since the last rotation. Rotation updates password and connection_info in place.", kind),
+		Optional:    true,
+		Attributes: map[string]schema.Attribute{
+			"rotation_trigger": schema.StringAttribute{
+				Description: "Arbitrary value; changing it rotates the password on the next apply, e.g. a date or a ticket number.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.AtLeastOneOf(path.MatchRelative().AtParent().AtName("rotate_after_days")),
+				},
+			},
+			"rotate_after_days": schema.Int64Attribute{
+				Description: "Rotate the password when a plan runs at least this many days after the last rotation (or after creation). Between 1 and 365.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 365),
+				},
+			},
+			"grace_period_minutes": schema.Int64Attribute{
+				Description: "Minutes the previous password stays valid after a rotation, so clients can switch to the new one without downtime. Between 0 and 10080 (7 days); 0, the default, revokes it immediately.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(0, 10080),
+				},
+			},
+		},
+	}
+}
+
+// passwordRotationDue reports whether the password must be rotated when
+// moving from the prior password_rotation value to the planned one: either
+// rotation_trigger changed, or rotate_after_days have passed since lastRotated.
+// An unknown planned value counts as due, so the plan shows the password as
+// changing; the decision is taken again at apply time with known values.
+func passwordRotationDue(ctx context.Context, planned, prior types.Object, lastRotated string, now time.Time, diags *diag.Diagnostics) bool {
+	if planned.IsNull() {
+		return false
+	}
+	if planned.IsUnknown() {
+		return true
+	}
+
+	var plan PasswordRotationModel
+	diags.Append(planned.As(ctx, &plan, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return false
+	}
+
+	if plan.RotationTrigger.IsUnknown() || plan.RotateAfterDays.IsUnknown() {
+		return true
+	}
+
+	if !plan.RotationTrigger.IsNull() {
+		priorTrigger := types.StringNull()
+		if !prior.IsNull() && !prior.IsUnknown() {
+			var state PasswordRotationModel
+			diags.Append(prior.As(ctx, &state, basetypes.ObjectAsOptions{})...)
+			priorTrigger = state.RotationTrigger
+		}
+		if !plan.RotationTrigger.Equal(priorTrigger) {
+			return true
+		}
+	}
+
+	if plan.RotateAfterDays.IsNull() {
+		return false
+	}
+	rotated, err := time.Parse(time.RFC3339, lastRotated)
+	if err != nil {
+		return false
+	}
+	return !now.Before(rotated.AddDate(0, 0, int(plan.RotateAfterDays.ValueInt64())))
+}
+
+// passwordRotationGracePeriod returns the configured grace_period_minutes, or
+// 0 when it is not set.
+func passwordRotationGracePeriod(ctx context.Context, obj types.Object, diags *diag.Diagnostics) int {
+	if obj.IsNull() || obj.IsUnknown() {
+		return 0
+	}
+	var rotation PasswordRotationModel
+	diags.Append(obj.As(ctx, &rotation, basetypes.ObjectAsOptions{})...)
+	return int(rotation.GracePeriodMinutes.ValueInt64())
+}
+
+// lastPasswordRotation returns the timestamp rotate_after_days counts from:
+// the last rotation, or the creation of the instance if it was never rotated.
+func lastPasswordRotation(rotatedAt, createdAt types.String) string {
+	if !rotatedAt.IsNull() && !rotatedAt.IsUnknown() {
+		return rotatedAt.ValueString()
+	}
+	return createdAt.ValueString()
+}
@@ -0,0 +1,68 @@
+package resources
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func testPasswordRotation(trigger types.String, days types.Int64) types.Object {
+	return types.ObjectValueMust(passwordRotationAttrTypes, map[string]attr.Value{
+		"rotation_trigger":     trigger,
+		"rotate_after_days":    days,
+		"grace_period_minutes": types.Int64Value(30),
+	})
+}
+
+func TestPasswordRotationDue(t *testing.T) {
+	now := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	null := types.ObjectNull(passwordRotationAttrTypes)
+	noDays := types.Int64Null()
+	noTrigger := types.StringNull()
+
+	tests := []struct {
+		name        string
+		planned     types.Object
+		prior       types.Object
+		lastRotated string
+		want        bool
+	}{
+		{name: "not configured", planned: null, prior: null, lastRotated: "2020-01-01T00:00:00Z"},
+		{name: "trigger unchanged", planned: testPasswordRotation(types.StringValue("q3"), noDays), prior: testPasswordRotation(types.StringValue("q3"), noDays)},
+		{name: "trigger changed", planned: testPasswordRotation(types.StringValue("q4"), noDays), prior: testPasswordRotation(types.StringValue("q3"), noDays), want: true},
+		{name: "trigger added", planned: testPasswordRotation(types.StringValue("q4"), noDays), prior: null, want: true},
+		{name: "trigger unknown", planned: testPasswordRotation(types.StringUnknown(), noDays), prior: null, want: true},
+		{name: "not yet due", planned: testPasswordRotation(noTrigger, types.Int64Value(90)), prior: null, lastRotated: "2026-07-04T12:00:01Z"},
+		{name: "due", planned: testPasswordRotation(noTrigger, types.Int64Value(90)), prior: null, lastRotated: "2026-07-03T12:00:00Z", want: true},
+		{name: "due with fractional seconds", planned: testPasswordRotation(noTrigger, types.Int64Value(90)), prior: null, lastRotated: "2026-06-01T08:30:00.000000Z", want: true},
+		{name: "unparseable timestamp", planned: testPasswordRotation(noTrigger, types.Int64Value(90)), prior: null, lastRotated: "yesterday"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			got := passwordRotationDue(context.Background(), tt.planned, tt.prior, tt.lastRotated, now, &diags)
+			if diags.HasError() {
+				t.Fatalf("unexpected errors: %v", diags)
+			}
+			if got != tt.want {
+				t.Errorf("passwordRotationDue() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLastPasswordRotation(t *testing.T) {
+	createdAt := types.StringValue("2026-01-01T00:00:00Z")
+
+	if got := lastPasswordRotation(types.StringNull(), createdAt); got != "2026-01-01T00:00:00Z" {
+		t.Errorf("lastPasswordRotation() = %q, want created_at when never rotated", got)
+	}
+	if got := lastPasswordRotation(types.StringValue("2026-04-01T00:00:00Z"), createdAt); got != "2026-04-01T00:00:00Z" {
+		t.Errorf("lastPasswordRotation() = %q, want the last rotation", got)
+	}
+}
@@ -0,0 +1,328 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/AdrianSilaghi/terraform-provider-danubedata/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &ServerlessDomainResource{}
+	_ resource.ResourceWithConfigure   = &ServerlessDomainResource{}
+	_ resource.ResourceWithImportState = &ServerlessDomainResource{}
+)
+
+type ServerlessDomainResource struct {
+	client *client.Client
+}
+
+type ServerlessDomainResourceModel struct {
+	ID                  types.String   `tfsdk:"id"`
+	ContainerID         types.String   `tfsdk:"container_id"`
+	DomainID            types.String   `tfsdk:"domain_id"`
+	Domain              types.String   `tfsdk:"domain"`
+	WaitForVerification types.Bool     `tfsdk:"wait_for_verification"`
+	WaitForTLS          types.Bool     `tfsdk:"wait_for_tls"`
+	VerificationStatus  types.String   `tfsdk:"verification_status"`
+	TLSStatus           types.String   `tfsdk:"tls_status"`
+	DNSInstructions     types.Object   `tfsdk:"dns_instructions"`
+	CreatedAt           types.String   `tfsdk:"created_at"`
+	Timeouts            timeouts.Value `tfsdk:"timeouts"`
+}
+
+func NewServerlessDomainResource() resource.Resource {
+	return &ServerlessDomainResource{}
+}
+
+func (r *ServerlessDomainResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_serverless_domain"
+}
+
+func (r *ServerlessDomainResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a custom domain attached to a DanubeData serverless container. Add the DNS record described in `dns_instructions`; with `wait_for_verification` or `wait_for_tls` the apply waits until the domain is verified or serving HTTPS.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Composite identifier in the form {container_id}/{domain}.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"container_id": schema.StringAttribute{
+				Description: "ID of the serverless container.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"domain_id": schema.StringAttribute{
+				Description: "ID of the domain attachment.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"domain": schema.StringAttribute{
+				Description: "The custom domain (e.g., api.example.com).",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"wait_for_verification": schema.BoolAttribute{
+				Description: "Wait until DNS ownership of the domain is verified, re-triggering verification while it is pending. Defaults to false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"wait_for_tls": schema.BoolAttribute{
+				Description: "Wait until the domain is verified and its TLS certificate is active. Defaults to false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"verification_status": schema.StringAttribute{
+				Description: "DNS ownership verification status (pending, verifying, verified, failed).",
+				Computed:    true,
+			},
+			"tls_status": schema.StringAttribute{
+				Description: "TLS certificate provisioning status for the domain (pending, provisioning, active, failed).",
+				Computed:    true,
+			},
+			"dns_instructions": schema.SingleNestedAttribute{
+				Description: "DNS record to add to route the domain to the container and prove ownership.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"record_type": schema.StringAttribute{
+						Description: "DNS record type (e.g., CNAME).",
+						Computed:    true,
+					},
+					"record_name": schema.StringAttribute{
+						Description: "DNS record name.",
+						Computed:    true,
+					},
+					"record_value": schema.StringAttribute{
+						Description: "DNS record value.",
+						Computed:    true,
+					},
+					"instructions": schema.StringAttribute{
+						Description: "Human-readable instructions for configuring the record.",
+						Computed:    true,
+					},
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Description: "Timestamp when the domain attachment was created.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+			}),
+		},
+	}
+}
+
+func (r *ServerlessDomainResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+	r.client = c
+}
+
+func (r *ServerlessDomainResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ServerlessDomainResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, 30*time.Minute)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	containerID := data.ContainerID.ValueString()
+	tflog.Debug(ctx, "Adding serverless domain", map[string]interface{}{
+		"container_id": containerID,
+		"domain":       data.Domain.ValueString(),
+	})
+
+	domain, err := r.client.AddServerlessDomain(ctx, containerID, client.AddServerlessDomainRequest{
+		Domain: data.Domain.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to add serverless domain", err.Error())
+		return
+	}
+
+	mapServerlessDomainToState(containerID, domain, &data, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.waitForDomain(ctx, &data, createTimeout, &resp.State, &resp.Diagnostics)
+}
+
+func (r *ServerlessDomainResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ServerlessDomainResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	containerID := data.ContainerID.ValueString()
+	domain, err := r.client.FindServerlessDomain(ctx, containerID, data.Domain.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Failed to read serverless domain", err.Error())
+		return
+	}
+
+	mapServerlessDomainToState(containerID, domain, &data, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ServerlessDomainResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Only the wait flags and timeouts can change in place; the attachment
+	// itself is immutable. Turning a wait flag on waits for the domain now.
+	var data, state ServerlessDomainResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := data.Timeouts.Update(ctx, 30*time.Minute)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = state.ID
+	data.DomainID = state.DomainID
+	data.VerificationStatus = state.VerificationStatus
+	data.TLSStatus = state.TLSStatus
+	data.DNSInstructions = state.DNSInstructions
+	data.CreatedAt = state.CreatedAt
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.waitForDomain(ctx, &data, updateTimeout, &resp.State, &resp.Diagnostics)
+}
+
+func (r *ServerlessDomainResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ServerlessDomainResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.DeleteServerlessDomain(ctx, data.ContainerID.ValueString(), data.DomainID.ValueString()); err != nil {
+		if client.IsNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError("Failed to delete serverless domain", err.Error())
+		return
+	}
+}
+
+func (r *ServerlessDomainResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Expected format: {container_id}/{domain}
+	containerID, domain, ok := strings.Cut(req.ID, "/")
+	if !ok || containerID == "" || domain == "" {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			fmt.Sprintf("Expected format: {container_id}/{domain}, got: %s", req.ID),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("container_id"), containerID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("domain"), domain)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("wait_for_verification"), false)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("wait_for_tls"), false)...)
+}
+
+// waitForDomain waits for verification and TLS as configured. State has
+// already been saved, so a failed wait leaves the domain tainted rather than
+// untracked.
+func (r *ServerlessDomainResource) waitForDomain(ctx context.Context, data *ServerlessDomainResourceModel, timeout time.Duration, state *tfsdk.State, diags *diag.Diagnostics) {
+	waitForTLS := data.WaitForTLS.ValueBool()
+	if !waitForTLS && !data.WaitForVerification.ValueBool() {
+		return
+	}
+
+	containerID := data.ContainerID.ValueString()
+	domain, err := r.client.WaitForServerlessDomain(ctx, containerID, data.Domain.ValueString(), waitForTLS, timeout)
+	if domain != nil {
+		mapServerlessDomainToState(containerID, domain, data, diags)
+		diags.Append(state.Set(ctx, data)...)
+	}
+	if err != nil {
+		diags.AddError("Serverless domain is not ready", err.Error())
+	}
+}
+
+func mapServerlessDomainToState(containerID string, domain *client.ServerlessDomain, data *ServerlessDomainResourceModel, diags *diag.Diagnostics) {
+	data.ID = types.StringValue(fmt.Sprintf("%s/%s", containerID, domain.Domain))
+	data.ContainerID = types.StringValue(containerID)
+	data.DomainID = types.StringValue(domain.ID)
+	data.Domain = types.StringValue(domain.Domain)
+	data.VerificationStatus = types.StringValue(domain.VerificationStatus)
+	data.TLSStatus = types.StringValue(domain.TLSStatus)
+
+	dnsInstructions, dnsDiags := types.ObjectValue(
+		staticSiteDomainDNSInstructionsAttrTypes,
+		map[string]attr.Value{
+			"record_type":  types.StringValue(domain.DNSInstructions.RecordType),
+			"record_name":  types.StringValue(domain.DNSInstructions.RecordName),
+			"record_value": types.StringValue(domain.DNSInstructions.RecordValue),
+			"instructions": types.StringValue(domain.DNSInstructions.Instructions),
+		},
+	)
+	diags.Append(dnsDiags...)
+	data.DNSInstructions = dnsInstructions
+
+	data.CreatedAt = types.StringValue(domain.CreatedAt)
+}
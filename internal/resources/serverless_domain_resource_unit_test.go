@@ -0,0 +1,42 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/AdrianSilaghi/terraform-provider-danubedata/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestMapServerlessDomainToState(t *testing.T) {
+	domain := &client.ServerlessDomain{
+		ID:                 "domain-7",
+		Domain:             "api.example.com",
+		VerificationStatus: "verified",
+		TLSStatus:          "active",
+		DNSInstructions: client.StaticSiteDomainDNSInstructions{
+			RecordType:  "CNAME",
+			RecordName:  "api.example.com",
+			RecordValue: "my-app.serverless.danubedata.ro",
+		},
+		CreatedAt: "2026-10-01T12:00:00Z",
+	}
+
+	var data ServerlessDomainResourceModel
+	var diags diag.Diagnostics
+	mapServerlessDomainToState("srv-123", domain, &data, &diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	// The ID must match the import format so imported and created domains agree.
+	if got := data.ID.ValueString(); got != "srv-123/api.example.com" {
+		t.Errorf("id = %q, want srv-123/api.example.com", got)
+	}
+	if got := data.DomainID.ValueString(); got != "domain-7" {
+		t.Errorf("domain_id = %q, want domain-7", got)
+	}
+	if got := data.DNSInstructions.Attributes()["record_type"]; !got.Equal(types.StringValue("CNAME")) {
+		t.Errorf("dns_instructions.record_type = %v, want CNAME", got)
+	}
+}
@@ -0,0 +1,496 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/AdrianSilaghi/terraform-provider-danubedata/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &ServerlessJobResource{}
+	_ resource.ResourceWithConfigure   = &ServerlessJobResource{}
+	_ resource.ResourceWithImportState = &ServerlessJobResource{}
+)
+
+type ServerlessJobResource struct {
+	client *client.Client
+}
+
+type ServerlessJobResourceModel struct {
+	ID                   types.String `tfsdk:"id"`
+	Name                 types.String `tfsdk:"name"`
+	Schedule             types.String `tfsdk:"schedule"`
+	Timezone             types.String `tfsdk:"timezone"`
+	Image                types.String `tfsdk:"image"`
+	ImageTag             types.String `tfsdk:"image_tag"`
+	ContainerID          types.String `tfsdk:"container_id"`
+	Revision             types.Int64  `tfsdk:"revision"`
+	ResourceProfile      types.String `tfsdk:"resource_profile"`
+	Command              types.List   `tfsdk:"command"`
+	EnvironmentVariables types.Map    `tfsdk:"environment_variables"`
+	TimeoutSeconds       types.Int64  `tfsdk:"timeout_seconds"`
+	Retry                types.Object `tfsdk:"retry"`
+	Suspended            types.Bool   `tfsdk:"suspended"`
+	LastRun              types.Object `tfsdk:"last_run"`
+	NextRunAt            types.String `tfsdk:"next_run_at"`
+	CreatedAt            types.String `tfsdk:"created_at"`
+	UpdatedAt            types.String `tfsdk:"updated_at"`
+}
+
+// ServerlessJobRetryModel is the retry nested attribute.
+type ServerlessJobRetryModel struct {
+	MaxRetries   types.Int64 `tfsdk:"max_retries"`
+	DelaySeconds types.Int64 `tfsdk:"delay_seconds"`
+}
+
+var serverlessJobRetryAttrTypes = map[string]attr.Type{
+	"max_retries":   types.Int64Type,
+	"delay_seconds": types.Int64Type,
+}
+
+var serverlessJobRunAttrTypes = map[string]attr.Type{
+	"id":          types.StringType,
+	"status":      types.StringType,
+	"attempts":    types.Int64Type,
+	"exit_code":   types.Int64Type,
+	"started_at":  types.StringType,
+	"finished_at": types.StringType,
+}
+
+func NewServerlessJobResource() resource.Resource {
+	return &ServerlessJobResource{}
+}
+
+func (r *ServerlessJobResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_serverless_job"
+}
+
+func (r *ServerlessJobResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a DanubeData serverless job: a container image, or a revision of an existing serverless container, run to completion on a cron schedule.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Job identifier.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the job.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 63),
+				},
+			},
+			"schedule": schema.StringAttribute{
+				Description: "Cron expression with five fields (minute hour day-of-month month day-of-week), e.g. '0 3 * * *', or a macro such as '@daily'.",
+				Required:    true,
+				Validators: []validator.String{
+					cronExpression(),
+				},
+			},
+			"timezone": schema.StringAttribute{
+				Description: "IANA time zone the schedule is evaluated in, e.g. 'Europe/Bucharest'. Defaults to 'UTC'.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("UTC"),
+				Validators: []validator.String{
+					timeZone(),
+				},
+			},
+			"image": schema.StringAttribute{
+				Description: "Container image reference without a tag to run. Conflicts with container_id.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("container_id")),
+				},
+			},
+			"image_tag": schema.StringAttribute{
+				Description: "Image tag to run. Only applies with image. Defaults to 'latest'.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("latest"),
+			},
+			"container_id": schema.StringAttribute{
+				Description: "ID of a serverless container whose image and environment the job runs. Conflicts with image.",
+				Optional:    true,
+			},
+			"revision": schema.Int64Attribute{
+				Description: "Revision of container_id to run. Defaults to the container's latest revision at the time of each run.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+					int64validator.AlsoRequires(path.MatchRoot("container_id")),
+				},
+			},
+			"resource_profile": schema.StringAttribute{
+				Description: "Resource profile for the job's container (free, small, medium, or large).",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("small"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("free", "small", "medium", "large"),
+				},
+			},
+			"command": schema.ListAttribute{
+				Description: "Command to run instead of the image's default entrypoint and arguments.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"environment_variables": schema.MapAttribute{
+				Description: "Environment variables for the job. With container_id they are added to the container's own, overriding names set in both.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"timeout_seconds": schema.Int64Attribute{
+				Description: "Seconds a run may take before it is stopped and counted as timed out. Defaults to 3600.",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(3600),
+				Validators: []validator.Int64{
+					int64validator.Between(1, 86400),
+				},
+			},
+			"retry": schema.SingleNestedAttribute{
+				Description: "Retry policy for failed or timed-out runs. Without it, a failed run is not retried.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"max_retries": schema.Int64Attribute{
+						Description: "How many times a failed run is retried.",
+						Required:    true,
+						Validators: []validator.Int64{
+							int64validator.Between(1, 10),
+						},
+					},
+					"delay_seconds": schema.Int64Attribute{
+						Description: "Seconds to wait before each retry. Defaults to 60.",
+						Optional:    true,
+						Computed:    true,
+						Default:     int64default.StaticInt64(60),
+						Validators: []validator.Int64{
+							int64validator.Between(0, 3600),
+						},
+					},
+				},
+			},
+			"suspended": schema.BoolAttribute{
+				Description: "Stop scheduling new runs without deleting the job. Defaults to false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"last_run": schema.SingleNestedAttribute{
+				Description: "The most recent run, as of the last refresh. Null until the job has run.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Description: "Run identifier.",
+						Computed:    true,
+					},
+					"status": schema.StringAttribute{
+						Description: "Run status (running, succeeded, failed, timed_out).",
+						Computed:    true,
+					},
+					"attempts": schema.Int64Attribute{
+						Description: "Number of attempts, including retries.",
+						Computed:    true,
+					},
+					"exit_code": schema.Int64Attribute{
+						Description: "Exit code of the last attempt. Null while running or after a timeout.",
+						Computed:    true,
+					},
+					"started_at": schema.StringAttribute{
+						Description: "Timestamp when the run started.",
+						Computed:    true,
+					},
+					"finished_at": schema.StringAttribute{
+						Description: "Timestamp when the run finished. Null while running.",
+						Computed:    true,
+					},
+				},
+			},
+			"next_run_at": schema.StringAttribute{
+				Description: "Timestamp of the next scheduled run. Null while suspended.",
+				Computed:    true,
+			},
+			"created_at": schema.StringAttribute{
+				Description: "Timestamp when the job was created.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				Description: "Timestamp when the job was last updated.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *ServerlessJobResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+	r.client = c
+}
+
+func (r *ServerlessJobResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ServerlessJobResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	jobReq := expandServerlessJob(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating serverless job", map[string]interface{}{
+		"name":     jobReq.Name,
+		"schedule": jobReq.Schedule,
+	})
+
+	job, err := r.client.CreateServerlessJob(ctx, jobReq)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create serverless job", err.Error())
+		return
+	}
+
+	mapServerlessJobToState(ctx, job, &data, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ServerlessJobResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ServerlessJobResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	job, err := r.client.GetServerlessJob(ctx, data.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Failed to read serverless job", err.Error())
+		return
+	}
+
+	mapServerlessJobToState(ctx, job, &data, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ServerlessJobResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state ServerlessJobResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	jobReq := expandServerlessJob(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	job, err := r.client.UpdateServerlessJob(ctx, state.ID.ValueString(), jobReq)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update serverless job", err.Error())
+		return
+	}
+
+	mapServerlessJobToState(ctx, job, &data, &resp.Diagnostics)
+	// last_run was planned from state, and a run since the refresh must not
+	// change it here; the next refresh picks it up.
+	data.LastRun = state.LastRun
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ServerlessJobResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ServerlessJobResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.DeleteServerlessJob(ctx, data.ID.ValueString()); err != nil {
+		if client.IsNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError("Failed to delete serverless job", err.Error())
+		return
+	}
+}
+
+func (r *ServerlessJobResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// expandServerlessJob builds the full job request from the plan. Updates
+// replace the whole job, so Create and Update share it.
+func expandServerlessJob(ctx context.Context, data *ServerlessJobResourceModel, diags *diag.Diagnostics) client.ServerlessJobRequest {
+	jobReq := client.ServerlessJobRequest{
+		Name:            data.Name.ValueString(),
+		Schedule:        data.Schedule.ValueString(),
+		Timezone:        data.Timezone.ValueString(),
+		ResourceProfile: data.ResourceProfile.ValueString(),
+		TimeoutSeconds:  int(data.TimeoutSeconds.ValueInt64()),
+		Suspended:       data.Suspended.ValueBool(),
+	}
+
+	if !data.Image.IsNull() {
+		image := data.Image.ValueString()
+		jobReq.Image = &image
+		jobReq.ImageTag = data.ImageTag.ValueString()
+	}
+	if !data.ContainerID.IsNull() {
+		containerID := data.ContainerID.ValueString()
+		jobReq.ContainerID = &containerID
+	}
+	if !data.Revision.IsNull() {
+		revision := int(data.Revision.ValueInt64())
+		jobReq.Revision = &revision
+	}
+
+	if !data.Command.IsNull() {
+		diags.Append(data.Command.ElementsAs(ctx, &jobReq.Command, false)...)
+	}
+	if !data.EnvironmentVariables.IsNull() {
+		diags.Append(data.EnvironmentVariables.ElementsAs(ctx, &jobReq.EnvironmentVariables, false)...)
+	}
+
+	if !data.Retry.IsNull() {
+		var retry ServerlessJobRetryModel
+		diags.Append(data.Retry.As(ctx, &retry, basetypes.ObjectAsOptions{})...)
+		jobReq.MaxRetries = int(retry.MaxRetries.ValueInt64())
+		jobReq.RetryDelaySeconds = int(retry.DelaySeconds.ValueInt64())
+	}
+
+	return jobReq
+}
+
+func mapServerlessJobToState(ctx context.Context, job *client.ServerlessJob, data *ServerlessJobResourceModel, diags *diag.Diagnostics) {
+	data.ID = types.StringValue(job.ID)
+	data.Name = types.StringValue(job.Name)
+	data.Schedule = types.StringValue(job.Schedule)
+	data.Timezone = types.StringValue(job.Timezone)
+	data.ResourceProfile = types.StringValue(job.ResourceProfile)
+	data.TimeoutSeconds = types.Int64Value(int64(job.TimeoutSeconds))
+	data.Suspended = types.BoolValue(job.Suspended)
+
+	if job.Image != nil {
+		data.Image = types.StringValue(*job.Image)
+		data.ImageTag = types.StringValue(job.ImageTag)
+	} else {
+		data.Image = types.StringNull()
+	}
+	if job.ContainerID != nil {
+		data.ContainerID = types.StringValue(*job.ContainerID)
+	} else {
+		data.ContainerID = types.StringNull()
+	}
+	if job.Revision != nil {
+		data.Revision = types.Int64Value(int64(*job.Revision))
+	} else {
+		data.Revision = types.Int64Null()
+	}
+
+	if len(job.Command) > 0 {
+		command, d := types.ListValueFrom(ctx, types.StringType, job.Command)
+		diags.Append(d...)
+		data.Command = command
+	} else {
+		data.Command = types.ListNull(types.StringType)
+	}
+	if len(job.EnvironmentVariables) > 0 {
+		envVars, d := types.MapValueFrom(ctx, types.StringType, job.EnvironmentVariables)
+		diags.Append(d...)
+		data.EnvironmentVariables = envVars
+	} else {
+		data.EnvironmentVariables = types.MapNull(types.StringType)
+	}
+
+	if job.MaxRetries > 0 {
+		retry, d := types.ObjectValue(serverlessJobRetryAttrTypes, map[string]attr.Value{
+			"max_retries":   types.Int64Value(int64(job.MaxRetries)),
+			"delay_seconds": types.Int64Value(int64(job.RetryDelaySeconds)),
+		})
+		diags.Append(d...)
+		data.Retry = retry
+	} else {
+		data.Retry = types.ObjectNull(serverlessJobRetryAttrTypes)
+	}
+
+	data.LastRun = flattenServerlessJobRun(job.LastRun, diags)
+
+	if job.NextRunAt != nil {
+		data.NextRunAt = types.StringValue(*job.NextRunAt)
+	} else {
+		data.NextRunAt = types.StringNull()
+	}
+	data.CreatedAt = types.StringValue(job.CreatedAt)
+	data.UpdatedAt = types.StringValue(job.UpdatedAt)
+}
+
+func flattenServerlessJobRun(run *client.ServerlessJobRun, diags *diag.Diagnostics) types.Object {
+	if run == nil {
+		return types.ObjectNull(serverlessJobRunAttrTypes)
+	}
+
+	exitCode := types.Int64Null()
+	if run.ExitCode != nil {
+		exitCode = types.Int64Value(int64(*run.ExitCode))
+	}
+	finishedAt := types.StringNull()
+	if run.FinishedAt != nil {
+		finishedAt = types.StringValue(*run.FinishedAt)
+	}
+
+	obj, objDiags := types.ObjectValue(serverlessJobRunAttrTypes, map[string]attr.Value{
+		"id":          types.StringValue(run.ID),
+		"status":      types.StringValue(run.Status),
+		"attempts":    types.Int64Value(int64(run.Attempts)),
+		"exit_code":   exitCode,
+		"started_at":  types.StringValue(run.StartedAt),
+		"finished_at": finishedAt,
+	})
+	diags.Append(objDiags...)
+	return obj
+}
@@ -0,0 +1,235 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/AdrianSilaghi/terraform-provider-danubedata/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestParseCronExpression(t *testing.T) {
+	tests := []struct {
+		expr    string
+		wantErr string
+	}{
+		{expr: "0 3 * * *"},
+		{expr: "*/15 9-17 * * MON-FRI"},
+		{expr: "0 0 1,15 jan-jun 0"},
+		{expr: "30 4 * * 7"},
+		{expr: "0-30/10 * * * *"},
+		{expr: "@daily"},
+		{expr: "@HOURLY"},
+		{expr: "0 3 * *", wantErr: "expected 5 fields, got 4"},
+		{expr: "0 24 * * *", wantErr: `hour field "24": 24 is out of range 0-23`},
+		{expr: "0 0 0 * *", wantErr: "day-of-month field"},
+		{expr: "0 0 * 13 *", wantErr: "out of range 1-12"},
+		{expr: "*/0 * * * *", wantErr: "step \"0\" must be a positive number"},
+		{expr: "5/10 * * * *", wantErr: "step requires * or a range"},
+		{expr: "0 17-9 * * *", wantErr: "range 17-9 is backwards"},
+		{expr: "0 0 * * FUNDAY", wantErr: `"FUNDAY" is not a number`},
+		{expr: "@fortnightly", wantErr: "unknown macro"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			err := parseCronExpression(tt.expr)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("error = %v, want it to contain %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestParseTimeZone(t *testing.T) {
+	tests := []struct {
+		name    string
+		wantErr bool
+	}{
+		{name: "UTC"},
+		{name: "Europe/Bucharest"},
+		{name: "America/Argentina/Buenos_Aires"},
+		{name: "Europe/Bucharestt", wantErr: true},
+		{name: "GMT+2:00", wantErr: true},
+		{name: "Local", wantErr: true},
+		{name: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := parseTimeZone(tt.name)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("parseTimeZone(%q) error = %v, wantErr %v", tt.name, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestExpandServerlessJob(t *testing.T) {
+	ctx := context.Background()
+	var diags diag.Diagnostics
+
+	retry, d := types.ObjectValue(serverlessJobRetryAttrTypes, map[string]attr.Value{
+		"max_retries":   types.Int64Value(3),
+		"delay_seconds": types.Int64Value(120),
+	})
+	diags.Append(d...)
+	command, d := types.ListValueFrom(ctx, types.StringType, []string{"bin/report", "--daily"})
+	diags.Append(d...)
+
+	data := ServerlessJobResourceModel{
+		Name:                 types.StringValue("nightly-report"),
+		Schedule:             types.StringValue("0 3 * * *"),
+		Timezone:             types.StringValue("UTC"),
+		Image:                types.StringNull(),
+		ImageTag:             types.StringValue("latest"),
+		ContainerID:          types.StringValue("srv-123"),
+		Revision:             types.Int64Value(4),
+		ResourceProfile:      types.StringValue("small"),
+		Command:              command,
+		EnvironmentVariables: types.MapNull(types.StringType),
+		TimeoutSeconds:       types.Int64Value(1800),
+		Retry:                retry,
+		Suspended:            types.BoolValue(false),
+	}
+
+	req := expandServerlessJob(ctx, &data, &diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if req.Image != nil || req.ImageTag != "" {
+		t.Errorf("Image = %v, ImageTag = %q, want neither for a container job", req.Image, req.ImageTag)
+	}
+	if req.ContainerID == nil || *req.ContainerID != "srv-123" {
+		t.Errorf("ContainerID = %v, want srv-123", req.ContainerID)
+	}
+	if req.Revision == nil || *req.Revision != 4 {
+		t.Errorf("Revision = %v, want 4", req.Revision)
+	}
+	if req.MaxRetries != 3 || req.RetryDelaySeconds != 120 {
+		t.Errorf("retry = %d/%d, want 3/120", req.MaxRetries, req.RetryDelaySeconds)
+	}
+	if len(req.Command) != 2 || req.Command[0] != "bin/report" {
+		t.Errorf("Command = %v, want [bin/report --daily]", req.Command)
+	}
+}
+
+func TestMapServerlessJobToState_LastRun(t *testing.T) {
+	finishedAt := "2026-10-17T03:04:10Z"
+	exitCode := 0
+	job := &client.ServerlessJob{
+		ID:       "job-1",
+		Schedule: "0 3 * * *",
+		LastRun: &client.ServerlessJobRun{
+			ID:         "run-9",
+			Status:     "succeeded",
+			Attempts:   1,
+			ExitCode:   &exitCode,
+			StartedAt:  "2026-10-17T03:00:00Z",
+			FinishedAt: &finishedAt,
+		},
+	}
+
+	var data ServerlessJobResourceModel
+	var diags diag.Diagnostics
+	mapServerlessJobToState(context.Background(), job, &data, &diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	run := data.LastRun.Attributes()
+	if got := run["status"]; !got.Equal(types.StringValue("succeeded")) {
+		t.Errorf("last_run.status = %v, want succeeded", got)
+	}
+	if got := run["exit_code"]; !got.Equal(types.Int64Value(0)) {
+		t.Errorf("last_run.exit_code = %v, want 0", got)
+	}
+	if !data.Retry.IsNull() {
+		t.Errorf("retry = %v, want null without retries", data.Retry)
+	}
+	if !data.NextRunAt.IsNull() {
+		t.Errorf("next_run_at = %v, want null", data.NextRunAt)
+	}
+
+	job.LastRun = nil
+	mapServerlessJobToState(context.Background(), job, &data, &diags)
+	if !data.LastRun.IsNull() {
+		t.Errorf("last_run = %v, want null before the first run", data.LastRun)
+	}
+}
+
+func TestServerlessJobUpdate_KeepsPlannedLastRun(t *testing.T) {
+	ctx := context.Background()
+	image := "ghcr.io/acme/report"
+	job := client.ServerlessJob{
+		ID: "job-1", Name: "report", Schedule: "* * * * *", Timezone: "UTC", Image: &image, ImageTag: "v1",
+		ResourceProfile: "micro", TimeoutSeconds: 600,
+		LastRun: &client.ServerlessJobRun{ID: "run-1", Status: "succeeded", StartedAt: "2026-10-18T03:00:00Z"},
+	}
+
+	var schemaResp resource.SchemaResponse
+	(&ServerlessJobResource{}).Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	nullValue := tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)
+
+	var prior ServerlessJobResourceModel
+	var diags diag.Diagnostics
+	mapServerlessJobToState(ctx, &job, &prior, &diags)
+	state := tfsdk.State{Schema: schemaResp.Schema, Raw: nullValue}
+	diags.Append(state.Set(ctx, &prior)...)
+	planned := prior
+	planned.ImageTag = types.StringValue("v2")
+	plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: nullValue}
+	diags.Append(plan.Set(ctx, &planned)...)
+	if diags.HasError() {
+		t.Fatalf("unexpected errors: %v", diags)
+	}
+
+	// The job runs again between the refresh and the apply.
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "PUT" || r.URL.Path != "/serverless-jobs/job-1" {
+			t.Errorf("unexpected %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		updated := job
+		updated.ImageTag = "v2"
+		updated.LastRun = &client.ServerlessJobRun{ID: "run-2", Status: "running", StartedAt: "2026-10-18T03:01:00Z"}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"data": updated})
+	}))
+	defer server.Close()
+
+	r := &ServerlessJobResource{client: client.New(client.Config{BaseURL: server.URL})}
+	resp := resource.UpdateResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: nullValue}}
+	r.Update(ctx, resource.UpdateRequest{Plan: plan, State: state}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected errors: %v", resp.Diagnostics)
+	}
+
+	var got ServerlessJobResourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &got)...)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected errors: %v", resp.Diagnostics)
+	}
+	if !got.LastRun.Equal(prior.LastRun) {
+		t.Errorf("last_run = %v, want the planned %v", got.LastRun, prior.LastRun)
+	}
+	if got.ImageTag.ValueString() != "v2" {
+		t.Errorf("image_tag = %v, want v2", got.ImageTag)
+	}
+}
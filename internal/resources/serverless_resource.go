@@ -2,62 +2,150 @@ package resources
 
 import (
 	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
 	"fmt"
+	"regexp"
+	"sort"
 	"time"
 
 	"github.com/AdrianSilaghi/terraform-provider-danubedata/internal/client"
+	"github.com/AdrianSilaghi/terraform-provider-danubedata/internal/registry"
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 var (
-	_ resource.Resource                = &ServerlessResource{}
-	_ resource.ResourceWithConfigure   = &ServerlessResource{}
-	_ resource.ResourceWithImportState = &ServerlessResource{}
+	_ resource.Resource                   = &ServerlessResource{}
+	_ resource.ResourceWithConfigure      = &ServerlessResource{}
+	_ resource.ResourceWithImportState    = &ServerlessResource{}
+	_ resource.ResourceWithValidateConfig = &ServerlessResource{}
+	_ resource.ResourceWithModifyPlan     = &ServerlessResource{}
 )
 
 type ServerlessResource struct {
-	client *client.Client
+	client   *client.Client
+	resolver *registry.Resolver
 }
 
 type ServerlessResourceModel struct {
-	ID                   types.String   `tfsdk:"id"`
-	Name                 types.String   `tfsdk:"name"`
-	Status               types.String   `tfsdk:"status"`
-	ResourceProfile      types.String   `tfsdk:"resource_profile"`
-	DeploymentType       types.String   `tfsdk:"deployment_type"`
-	Image                types.String   `tfsdk:"image"`
-	ImageTag             types.String   `tfsdk:"image_tag"`
-	RepositoryURL        types.String   `tfsdk:"repository_url"`
-	RepositoryBranch     types.String   `tfsdk:"repository_branch"`
-	SourceType           types.String   `tfsdk:"source_type"`
-	GitAuthType          types.String   `tfsdk:"git_auth_type"`
-	GitCredentials       types.String   `tfsdk:"git_credentials"`
-	Port                 types.Int64    `tfsdk:"port"`
-	MinScale             types.Int64    `tfsdk:"min_scale"`
-	MaxScale             types.Int64    `tfsdk:"max_scale"`
-	EnvironmentVariables types.Map      `tfsdk:"environment_variables"`
-	URL                  types.String   `tfsdk:"url"`
-	MonthlyCost          types.Float64  `tfsdk:"monthly_cost"`
-	CreatedAt            types.String   `tfsdk:"created_at"`
-	UpdatedAt            types.String   `tfsdk:"updated_at"`
-	Timeouts             timeouts.Value `tfsdk:"timeouts"`
+	ID                               types.String   `tfsdk:"id"`
+	Name                             types.String   `tfsdk:"name"`
+	Status                           types.String   `tfsdk:"status"`
+	ResourceProfile                  types.String   `tfsdk:"resource_profile"`
+	DeploymentType                   types.String   `tfsdk:"deployment_type"`
+	Image                            types.String   `tfsdk:"image"`
+	ImageTag                         types.String   `tfsdk:"image_tag"`
+	ResolveDigest                    types.Bool     `tfsdk:"resolve_digest"`
+	ImageDigest                      types.String   `tfsdk:"image_digest"`
+	RegistryUsername                 types.String   `tfsdk:"registry_username"`
+	RegistryPassword                 types.String   `tfsdk:"registry_password"`
+	RepositoryURL                    types.String   `tfsdk:"repository_url"`
+	RepositoryBranch                 types.String   `tfsdk:"repository_branch"`
+	SourceType                       types.String   `tfsdk:"source_type"`
+	GitAuthType                      types.String   `tfsdk:"git_auth_type"`
+	GitCredentials                   types.String   `tfsdk:"git_credentials"`
+	Port                             types.Int64    `tfsdk:"port"`
+	MinScale                         types.Int64    `tfsdk:"min_scale"`
+	MaxScale                         types.Int64    `tfsdk:"max_scale"`
+	HealthCheck                      types.Object   `tfsdk:"health_check"`
+	Autoscaling                      types.Object   `tfsdk:"autoscaling"`
+	EnvironmentVariables             types.Map      `tfsdk:"environment_variables"`
+	SecretEnvironmentVariables       types.Map      `tfsdk:"secret_environment_variables"`
+	SecretEnvironmentVariablesWO     types.Map      `tfsdk:"secret_environment_variables_wo"`
+	SecretEnvironmentVariablesSHA256 types.String   `tfsdk:"secret_environment_variables_sha256"`
+	SourceDir                        types.String   `tfsdk:"source_dir"`
+	SourceZip                        types.String   `tfsdk:"source_zip"`
+	SourceSHA256                     types.String   `tfsdk:"source_sha256"`
+	Revision                         types.Int64    `tfsdk:"revision"`
+	Traffic                          types.List     `tfsdk:"traffic"`
+	PinnedRevision                   types.Int64    `tfsdk:"pinned_revision"`
+	URL                              types.String   `tfsdk:"url"`
+	MonthlyCost                      types.Float64  `tfsdk:"monthly_cost"`
+	CreatedAt                        types.String   `tfsdk:"created_at"`
+	UpdatedAt                        types.String   `tfsdk:"updated_at"`
+	Timeouts                         timeouts.Value `tfsdk:"timeouts"`
+}
+
+type ServerlessTrafficModel struct {
+	Revision       types.Int64 `tfsdk:"revision"`
+	LatestRevision types.Bool  `tfsdk:"latest_revision"`
+	Percent        types.Int64 `tfsdk:"percent"`
+}
+
+// serverlessTrafficAttrTypes describes the object type of an element of the traffic attribute.
+var serverlessTrafficAttrTypes = map[string]attr.Type{
+	"revision":        types.Int64Type,
+	"latest_revision": types.BoolType,
+	"percent":         types.Int64Type,
+}
+
+type ServerlessHealthCheckModel struct {
+	Path                  types.String `tfsdk:"path"`
+	IntervalSeconds       types.Int64  `tfsdk:"interval_seconds"`
+	TimeoutSeconds        types.Int64  `tfsdk:"timeout_seconds"`
+	FailureThreshold      types.Int64  `tfsdk:"failure_threshold"`
+	StartupTimeoutSeconds types.Int64  `tfsdk:"startup_timeout_seconds"`
+}
+
+// serverlessHealthCheckAttrTypes describes the object type of the health_check attribute.
+var serverlessHealthCheckAttrTypes = map[string]attr.Type{
+	"path":                    types.StringType,
+	"interval_seconds":        types.Int64Type,
+	"timeout_seconds":         types.Int64Type,
+	"failure_threshold":       types.Int64Type,
+	"startup_timeout_seconds": types.Int64Type,
+}
+
+// Schema defaults of health_check that validateServerlessScaling compares.
+const (
+	serverlessHealthCheckDefaultInterval = 10
+	serverlessHealthCheckDefaultTimeout  = 5
+)
+
+type ServerlessAutoscalingModel struct {
+	TargetConcurrency     types.Int64 `tfsdk:"target_concurrency"`
+	ScaleDownDelaySeconds types.Int64 `tfsdk:"scale_down_delay_seconds"`
+	RequestTimeoutSeconds types.Int64 `tfsdk:"request_timeout_seconds"`
+}
+
+// serverlessAutoscalingAttrTypes describes the object type of the autoscaling attribute.
+var serverlessAutoscalingAttrTypes = map[string]attr.Type{
+	"target_concurrency":       types.Int64Type,
+	"scale_down_delay_seconds": types.Int64Type,
+	"request_timeout_seconds":  types.Int64Type,
+}
+
+// serverlessAutoscalingDefaults is what the API reports for a container
+// without autoscaling configured; it matches the schema defaults.
+var serverlessAutoscalingDefaults = client.ServerlessAutoscaling{
+	TargetConcurrency:     100,
+	ScaleDownDelaySeconds: 300,
+	RequestTimeoutSeconds: 300,
 }
 
 func NewServerlessResource() resource.Resource {
-	return &ServerlessResource{}
+	return &ServerlessResource{resolver: registry.NewResolver()}
 }
 
 func (r *ServerlessResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -113,6 +201,28 @@ func (r *ServerlessResource) Schema(ctx context.Context, req resource.SchemaRequ
 				Computed:    true,
 				Default:     stringdefault.StaticString("latest"),
 			},
+			"resolve_digest": schema.BoolAttribute{
+				Description: "Resolve image_tag to a content digest in the image registry at plan time and deploy that exact digest, so a tag that moves (such as 'latest') produces a diff and a redeploy. Only applies to docker_image deployments. Defaults to false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"image_digest": schema.StringAttribute{
+				Description: "Digest (sha256:...) image_tag resolved to when resolve_digest is enabled; the container runs exactly this digest. Null otherwise.",
+				Computed:    true,
+			},
+			"registry_username": schema.StringAttribute{
+				Description: "Username for the image registry, used by the provider to resolve digests of private images. Requires registry_password.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("registry_password")),
+				},
+			},
+			"registry_password": schema.StringAttribute{
+				Description: "Password or access token for the image registry, used by the provider to resolve digests of private images.",
+				Optional:    true,
+				Sensitive:   true,
+			},
 			"repository_url": schema.StringAttribute{
 				Description: "Git repository URL (required for git_repository deployments). Can be changed after creation without replacing the container.",
 				Optional:    true,
@@ -172,11 +282,177 @@ func (r *ServerlessResource) Schema(ctx context.Context, req resource.SchemaRequ
 					int64validator.Between(1, 100),
 				},
 			},
+			"health_check": schema.SingleNestedAttribute{
+				Description: "HTTP health check used for readiness and liveness. Without one, an instance is ready as soon as its port accepts connections.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"path": schema.StringAttribute{
+						Description: "Path probed with an HTTP GET on the container port, e.g. '/healthz'. Any 2xx or 3xx response is healthy.",
+						Required:    true,
+						Validators: []validator.String{
+							stringvalidator.RegexMatches(regexp.MustCompile(`^/`), "must start with '/'"),
+						},
+					},
+					"interval_seconds": schema.Int64Attribute{
+						Description: "Seconds between probes. Defaults to 10.",
+						Optional:    true,
+						Computed:    true,
+						Default:     int64default.StaticInt64(serverlessHealthCheckDefaultInterval),
+						Validators: []validator.Int64{
+							int64validator.Between(1, 300),
+						},
+					},
+					"timeout_seconds": schema.Int64Attribute{
+						Description: "Seconds to wait for a probe response. Must not exceed interval_seconds. Defaults to 5.",
+						Optional:    true,
+						Computed:    true,
+						Default:     int64default.StaticInt64(serverlessHealthCheckDefaultTimeout),
+						Validators: []validator.Int64{
+							int64validator.Between(1, 60),
+						},
+					},
+					"failure_threshold": schema.Int64Attribute{
+						Description: "Consecutive failed probes after which an instance is restarted. Defaults to 3.",
+						Optional:    true,
+						Computed:    true,
+						Default:     int64default.StaticInt64(3),
+						Validators: []validator.Int64{
+							int64validator.Between(1, 10),
+						},
+					},
+					"startup_timeout_seconds": schema.Int64Attribute{
+						Description: "Seconds a new instance has to pass its first probe before it is restarted, including cold starts from zero. Raise it for slow-starting runtimes. Defaults to 60.",
+						Optional:    true,
+						Computed:    true,
+						Default:     int64default.StaticInt64(60),
+						Validators: []validator.Int64{
+							int64validator.Between(1, 900),
+						},
+					},
+				},
+			},
+			"autoscaling": schema.SingleNestedAttribute{
+				Description: "Concurrency-based autoscaling between min_scale and max_scale. Without it, the platform defaults apply.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"target_concurrency": schema.Int64Attribute{
+						Description: "In-flight requests per instance the autoscaler aims for. Defaults to 100.",
+						Optional:    true,
+						Computed:    true,
+						Default:     int64default.StaticInt64(100),
+						Validators: []validator.Int64{
+							int64validator.Between(1, 1000),
+						},
+					},
+					"scale_down_delay_seconds": schema.Int64Attribute{
+						Description: "Seconds an instance must be idle before it is removed, including the last one when min_scale is 0. Defaults to 300.",
+						Optional:    true,
+						Computed:    true,
+						Default:     int64default.StaticInt64(300),
+						Validators: []validator.Int64{
+							int64validator.Between(0, 3600),
+						},
+					},
+					"request_timeout_seconds": schema.Int64Attribute{
+						Description: "Seconds a request may take before it is aborted. Defaults to 300.",
+						Optional:    true,
+						Computed:    true,
+						Default:     int64default.StaticInt64(300),
+						Validators: []validator.Int64{
+							int64validator.Between(1, 3600),
+						},
+					},
+				},
+			},
 			"environment_variables": schema.MapAttribute{
-				Description: "Environment variables for the container.",
+				Description: "Environment variables for the container. Values are shown in plan output and stored in state; use secret_environment_variables for credentials.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"secret_environment_variables": schema.MapAttribute{
+				Description: "Secret environment variables for the container. Values are redacted in plan output and never returned by the API; drift is detected through secret_environment_variables_sha256. A name must not also appear in environment_variables. Conflicts with secret_environment_variables_wo.",
 				Optional:    true,
+				Sensitive:   true,
+				ElementType: types.StringType,
+				Validators: []validator.Map{
+					mapvalidator.ConflictsWith(path.MatchRoot("secret_environment_variables_wo")),
+				},
+			},
+			"secret_environment_variables_wo": schema.MapAttribute{
+				Description: "Write-only variant of secret_environment_variables that is never stored in state (requires Terraform 1.11 or later). Changes are detected through secret_environment_variables_sha256.",
+				Optional:    true,
+				Sensitive:   true,
+				WriteOnly:   true,
 				ElementType: types.StringType,
 			},
+			"secret_environment_variables_sha256": schema.StringAttribute{
+				Description: "HMAC, keyed by the container ID, of the secret environment variable names and value hashes. Computed from configuration at plan time and from the hashes the API reports on refresh, so a secret changed outside Terraform shows up as a diff. Unknown until the container exists.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"source_dir": schema.StringAttribute{
+				Description: "Local directory to package and upload for zip_upload deployments. The provider builds a deterministic ZIP (sorted entries, fixed timestamps) and honours .dockerignore and .gitignore at the directory root. Conflicts with source_zip.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("source_zip")),
+				},
+			},
+			"source_zip": schema.StringAttribute{
+				Description: "Path to a pre-built ZIP archive to upload as-is for zip_upload deployments. Conflicts with source_dir.",
+				Optional:    true,
+			},
+			"source_sha256": schema.StringAttribute{
+				Description: "Hex SHA-256 of the uploaded source archive. Computed at plan time from source_dir or source_zip; a change uploads the new source and redeploys the container.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"revision": schema.Int64Attribute{
+				Description: "Number of the latest revision. Every update that changes what runs (image, source, environment, scaling) deploys a new revision; past revisions are listed by the danubedata_serverless_revisions data source.",
+				Computed:    true,
+			},
+			"traffic": schema.ListNestedAttribute{
+				Description: "Splits requests between revisions, e.g. for canary rollouts. Percentages must add up to 100. When omitted, the latest revision receives all traffic. Conflicts with pinned_revision.",
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ConflictsWith(path.MatchRoot("pinned_revision")),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"revision": schema.Int64Attribute{
+							Description: "Revision number to route to. Set exactly one of revision and latest_revision.",
+							Optional:    true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+							},
+						},
+						"latest_revision": schema.BoolAttribute{
+							Description: "Route to whichever revision is latest, including one deployed by the same apply.",
+							Optional:    true,
+							Computed:    true,
+							Default:     booldefault.StaticBool(false),
+						},
+						"percent": schema.Int64Attribute{
+							Description: "Share of requests, between 0 and 100.",
+							Required:    true,
+							Validators: []validator.Int64{
+								int64validator.Between(0, 100),
+							},
+						},
+					},
+				},
+			},
+			"pinned_revision": schema.Int64Attribute{
+				Description: "Sends all traffic to this revision, rolling back to it without a rebuild. New revisions are still deployed while pinned but receive no traffic. Remove to route to the latest revision again. Conflicts with traffic.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
 			"url": schema.StringAttribute{
 				Description: "Public URL of the deployed service.",
 				Computed:    true,
@@ -250,6 +526,12 @@ func (r *ServerlessResource) Create(ctx context.Context, req resource.CreateRequ
 		MaxScale:        int(data.MaxScale.ValueInt64()),
 	}
 
+	createReq.HealthCheck = expandServerlessHealthCheck(ctx, data.HealthCheck, &resp.Diagnostics)
+	createReq.Autoscaling = expandServerlessAutoscaling(ctx, data.Autoscaling, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if !data.Image.IsNull() && !data.Image.IsUnknown() {
 		createReq.Image = data.Image.ValueString()
 	}
@@ -258,6 +540,14 @@ func (r *ServerlessResource) Create(ctx context.Context, req resource.CreateRequ
 		createReq.ImageTag = data.ImageTag.ValueString()
 	}
 
+	r.resolveUnknownImageDigest(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !data.ImageDigest.IsNull() {
+		createReq.ImageDigest = data.ImageDigest.ValueString()
+	}
+
 	if !data.RepositoryURL.IsNull() && !data.RepositoryURL.IsUnknown() {
 		createReq.RepositoryURL = data.RepositoryURL.ValueString()
 	}
@@ -287,6 +577,12 @@ func (r *ServerlessResource) Create(ctx context.Context, req resource.CreateRequ
 		createReq.EnvironmentVariables = envVars
 	}
 
+	secrets, _ := serverlessSecrets(ctx, req.Config, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	createReq.SecretEnvironmentVariables = secrets
+
 	container, err := r.client.CreateServerless(ctx, createReq)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to create serverless container", err.Error())
@@ -295,19 +591,33 @@ func (r *ServerlessResource) Create(ctx context.Context, req resource.CreateRequ
 
 	data.ID = types.StringValue(container.ID)
 
+	if hasServerlessSource(&data) {
+		// Track the container before uploading so a failed build leaves it
+		// in state (tainted) rather than orphaned.
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), data.ID)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		r.deployServerlessSource(ctx, container.ID, &data, createTimeout, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	tflog.Info(ctx, "Serverless container created, waiting for ready state", map[string]interface{}{
 		"id":   container.ID,
 		"name": container.Name,
 	})
 
 	// Wait for container to be ready
-	err = r.client.WaitForServerlessStatus(ctx, container.ID, "running", createTimeout)
-	if err != nil {
-		// Don't fail if just waiting times out - the container might still be deploying
-		tflog.Warn(ctx, "Serverless container did not reach running state within timeout", map[string]interface{}{
-			"id":    container.ID,
-			"error": err.Error(),
-		})
+	failed := r.waitForServerlessRunning(ctx, container.ID, createTimeout)
+
+	if failed == nil && (!data.Traffic.IsNull() || !data.PinnedRevision.IsNull()) {
+		r.setServerlessTraffic(ctx, container.ID, &data, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 	}
 
 	// Refresh state
@@ -320,6 +630,12 @@ func (r *ServerlessResource) Create(ctx context.Context, req resource.CreateRequ
 	r.mapContainerToState(ctx, container, &data, &resp.Diagnostics)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	// Reported after state is saved so the failed container is tracked (as
+	// tainted) rather than orphaned.
+	if failed != nil {
+		resp.Diagnostics.AddError("Serverless container failed", failed.Error())
+	}
 }
 
 func (r *ServerlessResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -370,8 +686,14 @@ func (r *ServerlessResource) Update(ctx context.Context, req resource.UpdateRequ
 		"id": data.ID.ValueString(),
 	})
 
-	updateReq := client.UpdateServerlessRequest{}
-	hasChanges := false
+	updateReq := client.UpdateServerlessRequest{
+		HealthCheck: expandServerlessHealthCheck(ctx, data.HealthCheck, &resp.Diagnostics),
+		Autoscaling: expandServerlessAutoscaling(ctx, data.Autoscaling, &resp.Diagnostics),
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	hasChanges := !data.HealthCheck.Equal(state.HealthCheck) || !data.Autoscaling.Equal(state.Autoscaling)
 
 	if !data.ResourceProfile.Equal(state.ResourceProfile) {
 		updateReq.ResourceProfile = data.ResourceProfile.ValueString()
@@ -388,6 +710,16 @@ func (r *ServerlessResource) Update(ctx context.Context, req resource.UpdateRequ
 		hasChanges = true
 	}
 
+	r.resolveUnknownImageDigest(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !data.ImageDigest.Equal(state.ImageDigest) {
+		digest := data.ImageDigest.ValueString()
+		updateReq.ImageDigest = &digest
+		hasChanges = true
+	}
+
 	if !data.RepositoryURL.Equal(state.RepositoryURL) && !data.RepositoryURL.IsNull() {
 		updateReq.RepositoryURL = data.RepositoryURL.ValueString()
 		hasChanges = true
@@ -440,20 +772,54 @@ func (r *ServerlessResource) Update(ctx context.Context, req resource.UpdateRequ
 		hasChanges = true
 	}
 
+	sourceChanged := hasServerlessSource(&data) && !data.SourceSHA256.Equal(state.SourceSHA256)
+	secretsChanged := !data.SecretEnvironmentVariablesSHA256.Equal(state.SecretEnvironmentVariablesSHA256)
+	trafficChanged := !data.Traffic.Equal(state.Traffic) || !data.PinnedRevision.Equal(state.PinnedRevision)
+
 	if hasChanges {
 		_, err := r.client.UpdateServerless(ctx, data.ID.ValueString(), updateReq)
 		if err != nil {
 			resp.Diagnostics.AddError("Failed to update serverless container", err.Error())
 			return
 		}
+	}
+
+	if secretsChanged {
+		secrets, _ := serverlessSecrets(ctx, req.Config, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		tflog.Debug(ctx, "Replacing serverless secret environment variables", map[string]interface{}{
+			"id":    data.ID.ValueString(),
+			"count": len(secrets),
+		})
+
+		if err := r.client.SetServerlessSecrets(ctx, data.ID.ValueString(), secrets); err != nil {
+			resp.Diagnostics.AddError("Failed to update serverless secret environment variables", err.Error())
+			return
+		}
+	}
+
+	if sourceChanged {
+		r.deployServerlessSource(ctx, data.ID.ValueString(), &data, updateTimeout, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
 
+	var failed *client.ServerlessFailedError
+	if hasChanges || sourceChanged || secretsChanged {
 		// Wait for update to complete
-		err = r.client.WaitForServerlessStatus(ctx, data.ID.ValueString(), "running", updateTimeout)
-		if err != nil {
-			tflog.Warn(ctx, "Serverless container did not reach running state after update", map[string]interface{}{
-				"id":    data.ID.ValueString(),
-				"error": err.Error(),
-			})
+		failed = r.waitForServerlessRunning(ctx, data.ID.ValueString(), updateTimeout)
+	}
+
+	// Traffic is switched last so that latest_revision targets the revision
+	// this apply deployed, and never onto a revision that failed.
+	if trafficChanged && failed == nil {
+		r.setServerlessTraffic(ctx, data.ID.ValueString(), &data, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
 		}
 	}
 
@@ -467,6 +833,10 @@ func (r *ServerlessResource) Update(ctx context.Context, req resource.UpdateRequ
 	r.mapContainerToState(ctx, container, &data, &resp.Diagnostics)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if failed != nil {
+		resp.Diagnostics.AddError("Serverless container failed after update", failed.Error())
+	}
 }
 
 func (r *ServerlessResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -506,6 +876,138 @@ func (r *ServerlessResource) Delete(ctx context.Context, req resource.DeleteRequ
 	}
 }
 
+func (r *ServerlessResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data ServerlessResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	validateServerlessScaling(ctx, &data, &resp.Diagnostics)
+	validateServerlessSecretNames(&data, &resp.Diagnostics)
+	validateServerlessTraffic(ctx, data.Traffic, &resp.Diagnostics)
+
+	if data.DeploymentType.IsUnknown() {
+		return
+	}
+
+	if data.ResolveDigest.ValueBool() && data.DeploymentType.ValueString() != "docker_image" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("resolve_digest"),
+			"Digest Resolution Requires docker_image Deployment",
+			fmt.Sprintf("resolve_digest only applies when deployment_type is 'docker_image', got %q; the platform builds the image for other deployment types.", data.DeploymentType.ValueString()),
+		)
+	}
+
+	if data.DeploymentType.ValueString() == "zip_upload" {
+		return
+	}
+
+	for _, attr := range []string{"source_dir", "source_zip"} {
+		var value types.String
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(attr), &value)...)
+		if !value.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(attr),
+				"Source Requires zip_upload Deployment",
+				fmt.Sprintf("%s is only used when deployment_type is 'zip_upload', got %q.", attr, data.DeploymentType.ValueString()),
+			)
+		}
+	}
+}
+
+// ModifyPlan hashes the configured source and secrets so that a content change,
+// rather than a path change, is what triggers a new upload, and so that secret
+// changes are visible even when the values themselves are write-only.
+func (r *ServerlessResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan ServerlessResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	switch {
+	case plan.SourceDir.IsUnknown() || plan.SourceZip.IsUnknown():
+		plan.SourceSHA256 = types.StringUnknown()
+	case hasServerlessSource(&plan):
+		// Only the hash is needed here; the archive itself is built on apply.
+		sum, err := sourceArchiveSHA256(plan.SourceDir.ValueString(), plan.SourceZip.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to package serverless source", err.Error())
+			return
+		}
+		plan.SourceSHA256 = types.StringValue(sum)
+	}
+
+	switch {
+	case plan.ResolveDigest.IsUnknown():
+		plan.ImageDigest = types.StringUnknown()
+	case !plan.ResolveDigest.ValueBool():
+		plan.ImageDigest = types.StringNull()
+	case plan.Image.IsUnknown() || plan.ImageTag.IsUnknown() || plan.RegistryUsername.IsUnknown() || plan.RegistryPassword.IsUnknown():
+		// Resolved during apply once the image reference is known.
+		plan.ImageDigest = types.StringUnknown()
+	default:
+		digest, err := r.resolveImageDigest(ctx, &plan)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("resolve_digest"), "Failed to resolve image digest", err.Error())
+			return
+		}
+		plan.ImageDigest = types.StringValue(digest)
+	}
+
+	secrets, known := serverlessSecrets(ctx, req.Config, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	switch {
+	case known && len(secrets) == 0:
+		plan.SecretEnvironmentVariablesSHA256 = types.StringNull()
+	case !known || plan.ID.IsUnknown():
+		// The digest is keyed by the container ID, which a new container
+		// only gets during apply.
+		plan.SecretEnvironmentVariablesSHA256 = types.StringUnknown()
+	default:
+		digest := secretEnvironmentDigest(plan.ID.ValueString(), hashSecretValues(secrets))
+		plan.SecretEnvironmentVariablesSHA256 = types.StringValue(digest)
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("source_sha256"), plan.SourceSHA256)...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("image_digest"), plan.ImageDigest)...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("secret_environment_variables_sha256"), plan.SecretEnvironmentVariablesSHA256)...)
+
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	var state ServerlessResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.checkServerlessRevisions(ctx, &plan, &state, &resp.Diagnostics)
+
+	if plan.SourceSHA256.Equal(state.SourceSHA256) &&
+		plan.SecretEnvironmentVariablesSHA256.Equal(state.SecretEnvironmentVariablesSHA256) &&
+		plan.ImageDigest.Equal(state.ImageDigest) {
+		return
+	}
+
+	// A content-only change leaves the configuration untouched, so the framework
+	// has kept every computed attribute at its prior value. The redeploy
+	// changes these, so they must be left open in the plan.
+	for _, attr := range []string{"status", "updated_at"} {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root(attr), types.StringUnknown())...)
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("monthly_cost"), types.Float64Unknown())...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("revision"), types.Int64Unknown())...)
+}
+
 func (r *ServerlessResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
@@ -517,6 +1019,19 @@ func (r *ServerlessResource) mapContainerToState(ctx context.Context, container
 	data.ResourceProfile = types.StringValue(container.ResourceProfile)
 	data.DeploymentType = types.StringValue(container.DeploymentType)
 	data.ImageTag = types.StringValue(container.ImageTag)
+
+	// The API echoes the digest the container is pinned to; without
+	// resolve_digest nothing is pinned. A digest the API leaves out keeps the
+	// planned or prior value, which was resolved by the provider.
+	switch {
+	case !data.ResolveDigest.ValueBool():
+		data.ImageDigest = types.StringNull()
+	case container.ImageDigest != nil && *container.ImageDigest != "":
+		data.ImageDigest = types.StringValue(*container.ImageDigest)
+	case data.ImageDigest.IsUnknown():
+		data.ImageDigest = types.StringNull()
+	}
+
 	data.RepositoryBranch = types.StringValue(container.RepositoryBranch)
 	data.GitAuthType = types.StringValue(container.GitAuthType)
 	data.Port = types.Int64Value(int64(container.Port))
@@ -526,6 +1041,37 @@ func (r *ServerlessResource) mapContainerToState(ctx context.Context, container
 	data.MonthlyCost = types.Float64Value(container.MonthlyCost)
 	data.CreatedAt = types.StringValue(container.CreatedAt)
 	data.UpdatedAt = types.StringValue(container.UpdatedAt)
+	data.Revision = types.Int64Value(int64(container.Revision))
+
+	// Both blocks are always refreshed, so that a health check or autoscaling
+	// settings added outside Terraform show up as drift. Without autoscaling
+	// configured the API reports the platform defaults, which are not drift.
+	if container.HealthCheck == nil {
+		data.HealthCheck = types.ObjectNull(serverlessHealthCheckAttrTypes)
+	} else {
+		data.HealthCheck = flattenServerlessHealthCheck(container.HealthCheck, diags)
+	}
+	switch {
+	case container.Autoscaling == nil,
+		data.Autoscaling.IsNull() && *container.Autoscaling == serverlessAutoscalingDefaults:
+		data.Autoscaling = types.ObjectNull(serverlessAutoscalingAttrTypes)
+	default:
+		data.Autoscaling = flattenServerlessAutoscaling(container.Autoscaling, diags)
+	}
+
+	if container.PinnedRevision != nil {
+		data.PinnedRevision = types.Int64Value(int64(*container.PinnedRevision))
+	} else {
+		data.PinnedRevision = types.Int64Null()
+	}
+
+	// Without a traffic block the API routes everything to the latest
+	// revision; only report a split that differs from that default.
+	if len(container.Traffic) == 0 || (data.Traffic.IsNull() && isDefaultServerlessTraffic(container.Traffic)) {
+		data.Traffic = types.ListNull(types.ObjectType{AttrTypes: serverlessTrafficAttrTypes})
+	} else {
+		data.Traffic = flattenServerlessTraffic(container.Traffic, diags)
+	}
 
 	if container.Image != nil {
 		data.Image = types.StringValue(*container.Image)
@@ -545,15 +1091,530 @@ func (r *ServerlessResource) mapContainerToState(ctx context.Context, container
 		data.RepositoryURL = types.StringNull()
 	}
 
+	// Containers whose source was never uploaded by the provider report no
+	// digest; keep whatever the plan/prior state holds in that case.
+	if container.SourceSHA256 != nil && *container.SourceSHA256 != "" {
+		data.SourceSHA256 = types.StringValue(*container.SourceSHA256)
+	} else if data.SourceSHA256.IsUnknown() {
+		data.SourceSHA256 = types.StringNull()
+	}
+
 	// git_credentials is a write-only secret: the API never echoes it back
 	// ($hidden on the model), so state is left untouched here and simply
 	// carries forward whatever the plan/prior state already had.
+	// secret_environment_variables is handled the same way; only the value
+	// hashes come back, and they feed the digest used for drift detection.
+	if container.SecretEnvironmentVariableHashes != nil {
+		if digest := secretEnvironmentDigest(container.ID, container.SecretEnvironmentVariableHashes); digest != "" {
+			data.SecretEnvironmentVariablesSHA256 = types.StringValue(digest)
+		} else {
+			data.SecretEnvironmentVariablesSHA256 = types.StringNull()
+		}
+	} else if data.SecretEnvironmentVariablesSHA256.IsUnknown() {
+		data.SecretEnvironmentVariablesSHA256 = types.StringNull()
+	}
 
-	if len(container.EnvironmentVariables) > 0 {
-		envVars, envDiags := types.MapValueFrom(ctx, types.StringType, container.EnvironmentVariables)
+	envVars := make(map[string]string, len(container.EnvironmentVariables))
+	for name, value := range container.EnvironmentVariables {
+		if _, secret := container.SecretEnvironmentVariableHashes[name]; secret {
+			continue
+		}
+		envVars[name] = value
+	}
+	if len(envVars) > 0 {
+		envVarsValue, envDiags := types.MapValueFrom(ctx, types.StringType, envVars)
 		diags.Append(envDiags...)
-		data.EnvironmentVariables = envVars
+		data.EnvironmentVariables = envVarsValue
 	} else {
 		data.EnvironmentVariables = types.MapNull(types.StringType)
 	}
 }
+
+// waitForServerlessRunning waits for a container to reach the running state.
+// Timeouts are only logged, since the container might still be deploying. A
+// failure is returned, with the tail of its logs, for the caller to report once
+// state has been saved.
+func (r *ServerlessResource) waitForServerlessRunning(ctx context.Context, id string, timeout time.Duration) *client.ServerlessFailedError {
+	err := r.client.WaitForServerlessStatus(ctx, id, "running", timeout)
+	if err == nil {
+		return nil
+	}
+
+	var failed *client.ServerlessFailedError
+	if errors.As(err, &failed) {
+		return failed
+	}
+
+	tflog.Warn(ctx, "Serverless container did not reach running state within timeout", map[string]interface{}{
+		"id":    id,
+		"error": err.Error(),
+	})
+	return nil
+}
+
+// resolveImageDigest looks up the digest image_tag currently points to.
+func (r *ServerlessResource) resolveImageDigest(ctx context.Context, data *ServerlessResourceModel) (string, error) {
+	if data.Image.IsNull() || data.Image.ValueString() == "" {
+		return "", fmt.Errorf("resolve_digest requires image to be set")
+	}
+
+	ref, err := registry.ParseReference(data.Image.ValueString(), data.ImageTag.ValueString())
+	if err != nil {
+		return "", err
+	}
+
+	tflog.Debug(ctx, "Resolving serverless image digest", map[string]interface{}{
+		"reference": ref.String(),
+	})
+
+	return r.resolver.ResolveDigest(ctx, ref, registry.Credentials{
+		Username: data.RegistryUsername.ValueString(),
+		Password: data.RegistryPassword.ValueString(),
+	})
+}
+
+// resolveUnknownImageDigest resolves a digest left unknown at plan time because
+// the image reference depended on another resource.
+func (r *ServerlessResource) resolveUnknownImageDigest(ctx context.Context, data *ServerlessResourceModel, diags *diag.Diagnostics) {
+	if !data.ImageDigest.IsUnknown() {
+		return
+	}
+	if !data.ResolveDigest.ValueBool() {
+		data.ImageDigest = types.StringNull()
+		return
+	}
+
+	digest, err := r.resolveImageDigest(ctx, data)
+	if err != nil {
+		diags.AddAttributeError(path.Root("resolve_digest"), "Failed to resolve image digest", err.Error())
+		return
+	}
+	data.ImageDigest = types.StringValue(digest)
+}
+
+// hasServerlessSource reports whether the model configures local source to upload.
+func hasServerlessSource(data *ServerlessResourceModel) bool {
+	return (!data.SourceDir.IsNull() && !data.SourceDir.IsUnknown()) ||
+		(!data.SourceZip.IsNull() && !data.SourceZip.IsUnknown())
+}
+
+// deployServerlessSource packages and uploads the configured source, then waits
+// for the resulting build. A failed build is reported with the platform's reason.
+func (r *ServerlessResource) deployServerlessSource(ctx context.Context, id string, data *ServerlessResourceModel, timeout time.Duration, diags *diag.Diagnostics) {
+	archive, sum, err := loadSourceArchive(data.SourceDir.ValueString(), data.SourceZip.ValueString())
+	if err != nil {
+		diags.AddError("Failed to package serverless source", err.Error())
+		return
+	}
+
+	if !data.SourceSHA256.IsUnknown() && data.SourceSHA256.ValueString() != sum {
+		diags.AddError(
+			"Serverless source changed after plan",
+			fmt.Sprintf("The source archive hashed to %s at plan time but %s now. Re-run terraform plan.", data.SourceSHA256.ValueString(), sum),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Uploading serverless source", map[string]interface{}{
+		"id":            id,
+		"size_bytes":    len(archive),
+		"source_sha256": sum,
+	})
+
+	build, err := r.client.UploadServerlessSource(ctx, id, archive, sum)
+	if err != nil {
+		diags.AddError("Failed to upload serverless source", err.Error())
+		return
+	}
+
+	if _, err := r.client.WaitForServerlessBuild(ctx, id, build.ID, timeout); err != nil {
+		diags.AddError(
+			"Serverless build failed",
+			fmt.Sprintf("Build %s of serverless container %s did not succeed: %s", build.ID, id, err),
+		)
+		return
+	}
+
+	data.SourceSHA256 = types.StringValue(sum)
+}
+
+// serverlessSecretAttributes are the two ways of configuring secret
+// environment variables; at most one of them is set.
+var serverlessSecretAttributes = []string{"secret_environment_variables", "secret_environment_variables_wo"}
+
+// serverlessSecrets returns the configured secret environment variables. They
+// are read from configuration because the write-only variant is never present
+// in plan or state. The second result is false while any value is unknown.
+func serverlessSecrets(ctx context.Context, config tfsdk.Config, diags *diag.Diagnostics) (map[string]string, bool) {
+	for _, attr := range serverlessSecretAttributes {
+		var value types.Map
+		diags.Append(config.GetAttribute(ctx, path.Root(attr), &value)...)
+		if value.IsUnknown() {
+			return nil, false
+		}
+		if value.IsNull() {
+			continue
+		}
+		for _, element := range value.Elements() {
+			if element.IsUnknown() {
+				return nil, false
+			}
+		}
+
+		secrets := make(map[string]string)
+		diags.Append(value.ElementsAs(ctx, &secrets, false)...)
+		return secrets, true
+	}
+	return nil, true
+}
+
+// validateServerlessSecretNames rejects names set both as a plain and a secret
+// environment variable, since only one of the two values could win.
+func validateServerlessSecretNames(data *ServerlessResourceModel, diags *diag.Diagnostics) {
+	if data.EnvironmentVariables.IsNull() || data.EnvironmentVariables.IsUnknown() {
+		return
+	}
+	plain := data.EnvironmentVariables.Elements()
+
+	for _, attr := range serverlessSecretAttributes {
+		secrets := data.SecretEnvironmentVariables
+		if attr == "secret_environment_variables_wo" {
+			secrets = data.SecretEnvironmentVariablesWO
+		}
+
+		names := make([]string, 0, len(secrets.Elements()))
+		for name := range secrets.Elements() {
+			if _, ok := plain[name]; ok {
+				names = append(names, name)
+			}
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			diags.AddAttributeError(
+				path.Root(attr).AtMapKey(name),
+				"Duplicate Environment Variable",
+				fmt.Sprintf("%q is set in both environment_variables and %s. Remove it from one of them.", name, attr),
+			)
+		}
+	}
+}
+
+// hashSecretValues returns the hex SHA-256 of each secret value, matching the
+// hashes the API reports in secret_environment_variable_hashes.
+func hashSecretValues(secrets map[string]string) map[string]string {
+	hashes := make(map[string]string, len(secrets))
+	for name, value := range secrets {
+		sum := sha256.Sum256([]byte(value))
+		hashes[name] = hex.EncodeToString(sum[:])
+	}
+	return hashes
+}
+
+// secretEnvironmentDigest folds per-secret hashes into a single digest that
+// changes when any value changes or a name is added or removed. It returns ""
+// when there are no secrets.
+//
+// The digest is an HMAC keyed by the container ID rather than a plain hash, so
+// that the same secret stored on different containers does not produce the
+// same digest in state, and a guess at a value has to be checked per container.
+func secretEnvironmentDigest(containerID string, hashes map[string]string) string {
+	if len(hashes) == 0 {
+		return ""
+	}
+
+	names := make([]string, 0, len(hashes))
+	for name := range hashes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	h := hmac.New(sha256.New, []byte(containerID))
+	for _, name := range names {
+		fmt.Fprintf(h, "%s=%s\n", name, hashes[name])
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// setServerlessTraffic applies the configured traffic split or pinned revision.
+// With neither set, all traffic returns to the latest revision.
+func (r *ServerlessResource) setServerlessTraffic(ctx context.Context, id string, data *ServerlessResourceModel, diags *diag.Diagnostics) {
+	trafficReq := client.SetServerlessTrafficRequest{
+		Traffic: expandServerlessTraffic(ctx, data.Traffic, diags),
+	}
+	if diags.HasError() {
+		return
+	}
+	if !data.PinnedRevision.IsNull() {
+		pinned := int(data.PinnedRevision.ValueInt64())
+		trafficReq.PinnedRevision = &pinned
+	}
+
+	tflog.Debug(ctx, "Updating serverless traffic", map[string]interface{}{
+		"id":              id,
+		"targets":         len(trafficReq.Traffic),
+		"pinned_revision": trafficReq.PinnedRevision,
+	})
+
+	if _, err := r.client.SetServerlessTraffic(ctx, id, trafficReq); err != nil {
+		diags.AddError("Failed to update serverless traffic", err.Error())
+	}
+}
+
+// checkServerlessRevisions verifies at plan time that the revisions referenced by
+// pinned_revision and traffic exist and did not fail to deploy, so a rollback to
+// a bad revision is caught before apply. Only existing containers are checked.
+func (r *ServerlessResource) checkServerlessRevisions(ctx context.Context, plan, state *ServerlessResourceModel, diags *diag.Diagnostics) {
+	if r.client == nil || (plan.Traffic.Equal(state.Traffic) && plan.PinnedRevision.Equal(state.PinnedRevision)) {
+		return
+	}
+
+	referenced := map[int64]path.Path{}
+	if !plan.PinnedRevision.IsNull() && !plan.PinnedRevision.IsUnknown() {
+		referenced[plan.PinnedRevision.ValueInt64()] = path.Root("pinned_revision")
+	}
+	if !plan.Traffic.IsNull() && !plan.Traffic.IsUnknown() {
+		var targets []ServerlessTrafficModel
+		diags.Append(plan.Traffic.ElementsAs(ctx, &targets, false)...)
+		for i, target := range targets {
+			if !target.Revision.IsNull() && !target.Revision.IsUnknown() {
+				referenced[target.Revision.ValueInt64()] = path.Root("traffic").AtListIndex(i).AtName("revision")
+			}
+		}
+	}
+	if len(referenced) == 0 {
+		return
+	}
+
+	revisions, err := r.client.ListServerlessRevisions(ctx, state.ID.ValueString())
+	if err != nil {
+		diags.AddError("Failed to list serverless revisions", err.Error())
+		return
+	}
+	status := make(map[int64]string, len(revisions))
+	for _, revision := range revisions {
+		status[int64(revision.Number)] = revision.Status
+	}
+
+	for number, attrPath := range referenced {
+		switch status[number] {
+		case "":
+			diags.AddAttributeError(attrPath, "Unknown Serverless Revision",
+				fmt.Sprintf("Serverless container %s has no revision %d.", state.ID.ValueString(), number))
+		case "failed":
+			diags.AddAttributeError(attrPath, "Failed Serverless Revision",
+				fmt.Sprintf("Revision %d of serverless container %s failed to deploy and cannot receive traffic.", number, state.ID.ValueString()))
+		}
+	}
+}
+
+// validateServerlessTraffic checks that every traffic target names exactly one
+// revision, that no revision is listed twice, and that the shares add up to 100.
+func validateServerlessTraffic(ctx context.Context, traffic types.List, diags *diag.Diagnostics) {
+	if traffic.IsNull() || traffic.IsUnknown() {
+		return
+	}
+
+	var targets []ServerlessTrafficModel
+	diags.Append(traffic.ElementsAs(ctx, &targets, false)...)
+	if diags.HasError() {
+		return
+	}
+
+	seen := map[string]bool{}
+	total := int64(0)
+	totalKnown := true
+	for i, target := range targets {
+		attrPath := path.Root("traffic").AtListIndex(i)
+		if target.Percent.IsUnknown() {
+			totalKnown = false
+		} else {
+			total += target.Percent.ValueInt64()
+		}
+		if target.Revision.IsUnknown() || target.LatestRevision.IsUnknown() {
+			continue
+		}
+
+		latest := target.LatestRevision.ValueBool()
+		if latest == !target.Revision.IsNull() {
+			diags.AddAttributeError(attrPath, "Invalid Traffic Target",
+				"Each traffic target must set exactly one of revision and latest_revision = true.")
+			continue
+		}
+
+		key := "the latest revision"
+		if !latest {
+			key = fmt.Sprintf("revision %d", target.Revision.ValueInt64())
+		}
+		if seen[key] {
+			diags.AddAttributeError(attrPath, "Duplicate Traffic Target",
+				fmt.Sprintf("Traffic lists %s more than once.", key))
+		}
+		seen[key] = true
+	}
+
+	if totalKnown && total != 100 {
+		diags.AddAttributeError(path.Root("traffic"), "Invalid Traffic Split",
+			fmt.Sprintf("Traffic percentages must add up to 100, got %d.", total))
+	}
+}
+
+// expandServerlessTraffic converts the traffic attribute into API traffic
+// targets. A null or unknown list yields no targets.
+func expandServerlessTraffic(ctx context.Context, traffic types.List, diags *diag.Diagnostics) []client.ServerlessTrafficTarget {
+	if traffic.IsNull() || traffic.IsUnknown() {
+		return nil
+	}
+
+	var targets []ServerlessTrafficModel
+	diags.Append(traffic.ElementsAs(ctx, &targets, false)...)
+	if diags.HasError() {
+		return nil
+	}
+
+	result := make([]client.ServerlessTrafficTarget, len(targets))
+	for i, target := range targets {
+		result[i] = client.ServerlessTrafficTarget{
+			LatestRevision: target.LatestRevision.ValueBool(),
+			Percent:        int(target.Percent.ValueInt64()),
+		}
+		if !target.Revision.IsNull() {
+			revision := int(target.Revision.ValueInt64())
+			result[i].Revision = &revision
+		}
+	}
+	return result
+}
+
+func flattenServerlessTraffic(targets []client.ServerlessTrafficTarget, diags *diag.Diagnostics) types.List {
+	elems := make([]attr.Value, len(targets))
+	for i, target := range targets {
+		revision := types.Int64Null()
+		if target.Revision != nil {
+			revision = types.Int64Value(int64(*target.Revision))
+		}
+		obj, objDiags := types.ObjectValue(serverlessTrafficAttrTypes, map[string]attr.Value{
+			"revision":        revision,
+			"latest_revision": types.BoolValue(target.LatestRevision),
+			"percent":         types.Int64Value(int64(target.Percent)),
+		})
+		diags.Append(objDiags...)
+		elems[i] = obj
+	}
+
+	list, listDiags := types.ListValue(types.ObjectType{AttrTypes: serverlessTrafficAttrTypes}, elems)
+	diags.Append(listDiags...)
+	return list
+}
+
+// isDefaultServerlessTraffic reports whether targets route everything to the
+// latest revision, which is what the API does when no split is configured.
+func isDefaultServerlessTraffic(targets []client.ServerlessTrafficTarget) bool {
+	return len(targets) == 1 && targets[0].LatestRevision && targets[0].Percent == 100
+}
+
+// validateServerlessScaling checks the relationships between scaling and health
+// check settings that single-attribute validators cannot express.
+func validateServerlessScaling(ctx context.Context, data *ServerlessResourceModel, diags *diag.Diagnostics) {
+	if !data.MinScale.IsNull() && !data.MinScale.IsUnknown() && !data.MaxScale.IsNull() && !data.MaxScale.IsUnknown() &&
+		data.MinScale.ValueInt64() > data.MaxScale.ValueInt64() {
+		diags.AddAttributeError(
+			path.Root("min_scale"),
+			"Invalid Scaling Bounds",
+			fmt.Sprintf("min_scale (%d) must not be greater than max_scale (%d).", data.MinScale.ValueInt64(), data.MaxScale.ValueInt64()),
+		)
+	}
+
+	if data.HealthCheck.IsNull() || data.HealthCheck.IsUnknown() {
+		return
+	}
+	var healthCheck ServerlessHealthCheckModel
+	diags.Append(data.HealthCheck.As(ctx, &healthCheck, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return
+	}
+
+	if healthCheck.TimeoutSeconds.IsUnknown() || healthCheck.IntervalSeconds.IsUnknown() {
+		return
+	}
+	// Defaults are not applied to the configuration yet, so unset values are
+	// compared as their schema defaults.
+	timeout, interval := int64(serverlessHealthCheckDefaultTimeout), int64(serverlessHealthCheckDefaultInterval)
+	if !healthCheck.TimeoutSeconds.IsNull() {
+		timeout = healthCheck.TimeoutSeconds.ValueInt64()
+	}
+	if !healthCheck.IntervalSeconds.IsNull() {
+		interval = healthCheck.IntervalSeconds.ValueInt64()
+	}
+	if timeout > interval {
+		diags.AddAttributeError(
+			path.Root("health_check").AtName("timeout_seconds"),
+			"Invalid Health Check Timeout",
+			fmt.Sprintf("timeout_seconds (%d) must not exceed interval_seconds (%d).", timeout, interval),
+		)
+	}
+}
+
+// expandServerlessHealthCheck converts the health_check attribute into its API
+// form. A null or unknown object yields nil.
+func expandServerlessHealthCheck(ctx context.Context, obj types.Object, diags *diag.Diagnostics) *client.ServerlessHealthCheck {
+	if obj.IsNull() || obj.IsUnknown() {
+		return nil
+	}
+
+	var model ServerlessHealthCheckModel
+	diags.Append(obj.As(ctx, &model, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return nil
+	}
+
+	return &client.ServerlessHealthCheck{
+		Path:                  model.Path.ValueString(),
+		IntervalSeconds:       int(model.IntervalSeconds.ValueInt64()),
+		TimeoutSeconds:        int(model.TimeoutSeconds.ValueInt64()),
+		FailureThreshold:      int(model.FailureThreshold.ValueInt64()),
+		StartupTimeoutSeconds: int(model.StartupTimeoutSeconds.ValueInt64()),
+	}
+}
+
+func flattenServerlessHealthCheck(healthCheck *client.ServerlessHealthCheck, diags *diag.Diagnostics) types.Object {
+	obj, objDiags := types.ObjectValue(serverlessHealthCheckAttrTypes, map[string]attr.Value{
+		"path":                    types.StringValue(healthCheck.Path),
+		"interval_seconds":        types.Int64Value(int64(healthCheck.IntervalSeconds)),
+		"timeout_seconds":         types.Int64Value(int64(healthCheck.TimeoutSeconds)),
+		"failure_threshold":       types.Int64Value(int64(healthCheck.FailureThreshold)),
+		"startup_timeout_seconds": types.Int64Value(int64(healthCheck.StartupTimeoutSeconds)),
+	})
+	diags.Append(objDiags...)
+	return obj
+}
+
+// expandServerlessAutoscaling converts the autoscaling attribute into its API
+// form. A null or unknown object yields nil.
+func expandServerlessAutoscaling(ctx context.Context, obj types.Object, diags *diag.Diagnostics) *client.ServerlessAutoscaling {
+	if obj.IsNull() || obj.IsUnknown() {
+		return nil
+	}
+
+	var model ServerlessAutoscalingModel
+	diags.Append(obj.As(ctx, &model, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return nil
+	}
+
+	return &client.ServerlessAutoscaling{
+		TargetConcurrency:     int(model.TargetConcurrency.ValueInt64()),
+		ScaleDownDelaySeconds: int(model.ScaleDownDelaySeconds.ValueInt64()),
+		RequestTimeoutSeconds: int(model.RequestTimeoutSeconds.ValueInt64()),
+	}
+}
+
+func flattenServerlessAutoscaling(autoscaling *client.ServerlessAutoscaling, diags *diag.Diagnostics) types.Object {
+	obj, objDiags := types.ObjectValue(serverlessAutoscalingAttrTypes, map[string]attr.Value{
+		"target_concurrency":       types.Int64Value(int64(autoscaling.TargetConcurrency)),
+		"scale_down_delay_seconds": types.Int64Value(int64(autoscaling.ScaleDownDelaySeconds)),
+		"request_timeout_seconds":  types.Int64Value(int64(autoscaling.RequestTimeoutSeconds)),
+	})
+	diags.Append(objDiags...)
+	return obj
+}
@@ -0,0 +1,420 @@
+package resources
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/AdrianSilaghi/terraform-provider-danubedata/internal/client"
+	"github.com/AdrianSilaghi/terraform-provider-danubedata/internal/registry"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSecretEnvironmentDigest(t *testing.T) {
+	base := hashSecretValues(map[string]string{"DB_PASSWORD": "s3cret", "API_KEY": "k"})
+
+	if got := secretEnvironmentDigest("srv-1", nil); got != "" {
+		t.Errorf("digest of no secrets = %q, want empty", got)
+	}
+	if got := secretEnvironmentDigest("srv-1", base); len(got) != 64 {
+		t.Errorf("digest = %q, want 64 hex characters", got)
+	}
+	if secretEnvironmentDigest("srv-1", base) != secretEnvironmentDigest("srv-1", hashSecretValues(map[string]string{"API_KEY": "k", "DB_PASSWORD": "s3cret"})) {
+		t.Error("digest should not depend on map order")
+	}
+	if secretEnvironmentDigest("srv-1", base) == secretEnvironmentDigest("srv-2", base) {
+		t.Error("digest should depend on the container ID")
+	}
+
+	changes := map[string]map[string]string{
+		"value changed": {"DB_PASSWORD": "other", "API_KEY": "k"},
+		"name removed":  {"DB_PASSWORD": "s3cret"},
+		"name added":    {"DB_PASSWORD": "s3cret", "API_KEY": "k", "TOKEN": "t"},
+		"name renamed":  {"DB_PASS": "s3cret", "API_KEY": "k"},
+	}
+	for name, secrets := range changes {
+		if secretEnvironmentDigest("srv-1", hashSecretValues(secrets)) == secretEnvironmentDigest("srv-1", base) {
+			t.Errorf("%s: digest did not change", name)
+		}
+	}
+}
+
+func TestHashSecretValues(t *testing.T) {
+	hashes := hashSecretValues(map[string]string{"DB_PASSWORD": "s3cret"})
+	want := "1ec1c26b50d5d3c58d9583181af8076655fe00756bf7285940ba3670f99fcba0"
+	if hashes["DB_PASSWORD"] != want {
+		t.Errorf("hash = %v, want %v", hashes["DB_PASSWORD"], want)
+	}
+}
+
+func TestValidateServerlessSecretNames(t *testing.T) {
+	stringMap := func(values map[string]string) types.Map {
+		elements := make(map[string]attr.Value, len(values))
+		for k, v := range values {
+			elements[k] = types.StringValue(v)
+		}
+		return types.MapValueMust(types.StringType, elements)
+	}
+
+	data := ServerlessResourceModel{
+		EnvironmentVariables:         stringMap(map[string]string{"LOG_LEVEL": "info", "DB_PASSWORD": "plain"}),
+		SecretEnvironmentVariables:   stringMap(map[string]string{"DB_PASSWORD": "s3cret", "API_KEY": "k"}),
+		SecretEnvironmentVariablesWO: types.MapNull(types.StringType),
+	}
+
+	var diags diag.Diagnostics
+	validateServerlessSecretNames(&data, &diags)
+	if diags.ErrorsCount() != 1 {
+		t.Fatalf("errors = %d, want 1: %v", diags.ErrorsCount(), diags)
+	}
+
+	data.SecretEnvironmentVariables = types.MapNull(types.StringType)
+	data.SecretEnvironmentVariablesWO = stringMap(map[string]string{"API_KEY": "k"})
+	diags = nil
+	validateServerlessSecretNames(&data, &diags)
+	if diags.HasError() {
+		t.Errorf("unexpected errors: %v", diags)
+	}
+}
+
+func TestMapContainerToState_SecretEnvironmentVariables(t *testing.T) {
+	hashes := hashSecretValues(map[string]string{"DB_PASSWORD": "s3cret"})
+	container := &client.ServerlessContainer{
+		ID: "srv-123",
+		EnvironmentVariables: map[string]string{
+			"LOG_LEVEL":   "info",
+			"DB_PASSWORD": "should-not-surface",
+		},
+		SecretEnvironmentVariableHashes: hashes,
+	}
+
+	secrets := types.MapValueMust(types.StringType, map[string]attr.Value{"DB_PASSWORD": types.StringValue("s3cret")})
+	data := ServerlessResourceModel{
+		SecretEnvironmentVariables:       secrets,
+		SecretEnvironmentVariablesSHA256: types.StringUnknown(),
+	}
+
+	var diags diag.Diagnostics
+	(&ServerlessResource{}).mapContainerToState(context.Background(), container, &data, &diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected errors: %v", diags)
+	}
+
+	if _, ok := data.EnvironmentVariables.Elements()["DB_PASSWORD"]; ok {
+		t.Error("environment_variables should not contain secret names")
+	}
+	if len(data.EnvironmentVariables.Elements()) != 1 {
+		t.Errorf("environment_variables count = %d, want 1", len(data.EnvironmentVariables.Elements()))
+	}
+	if !data.SecretEnvironmentVariables.Equal(secrets) {
+		t.Error("secret_environment_variables should be carried forward unchanged")
+	}
+	if got, want := data.SecretEnvironmentVariablesSHA256.ValueString(), secretEnvironmentDigest("srv-123", hashes); got != want {
+		t.Errorf("secret_environment_variables_sha256 = %v, want %v", got, want)
+	}
+
+	// An API without secret support reports no hashes at all.
+	container.SecretEnvironmentVariableHashes = nil
+	data.SecretEnvironmentVariablesSHA256 = types.StringUnknown()
+	(&ServerlessResource{}).mapContainerToState(context.Background(), container, &data, &diags)
+	if !data.SecretEnvironmentVariablesSHA256.IsNull() {
+		t.Errorf("secret_environment_variables_sha256 = %v, want null", data.SecretEnvironmentVariablesSHA256)
+	}
+}
+
+func TestValidateServerlessTraffic(t *testing.T) {
+	target := func(revision int64, latest bool, percent int64) attr.Value {
+		rev := types.Int64Null()
+		if revision > 0 {
+			rev = types.Int64Value(revision)
+		}
+		latestValue := types.BoolNull()
+		if latest {
+			latestValue = types.BoolValue(true)
+		}
+		return types.ObjectValueMust(serverlessTrafficAttrTypes, map[string]attr.Value{
+			"revision":        rev,
+			"latest_revision": latestValue,
+			"percent":         types.Int64Value(percent),
+		})
+	}
+	list := func(targets ...attr.Value) types.List {
+		return types.ListValueMust(types.ObjectType{AttrTypes: serverlessTrafficAttrTypes}, targets)
+	}
+
+	tests := []struct {
+		name    string
+		traffic types.List
+		errors  int
+	}{
+		{name: "null", traffic: types.ListNull(types.ObjectType{AttrTypes: serverlessTrafficAttrTypes}), errors: 0},
+		{name: "canary", traffic: list(target(3, false, 90), target(0, true, 10)), errors: 0},
+		{name: "two pinned revisions", traffic: list(target(2, false, 50), target(3, false, 50)), errors: 0},
+		{name: "does not add up", traffic: list(target(3, false, 90), target(0, true, 20)), errors: 1},
+		{name: "neither revision nor latest", traffic: list(target(0, false, 100)), errors: 1},
+		{name: "both revision and latest", traffic: list(target(3, true, 100)), errors: 1},
+		{name: "duplicate revision", traffic: list(target(3, false, 50), target(3, false, 50)), errors: 1},
+		{name: "duplicate latest", traffic: list(target(0, true, 50), target(0, true, 50)), errors: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			validateServerlessTraffic(context.Background(), tt.traffic, &diags)
+			if diags.ErrorsCount() != tt.errors {
+				t.Errorf("errors = %d, want %d: %v", diags.ErrorsCount(), tt.errors, diags)
+			}
+		})
+	}
+}
+
+func TestMapContainerToState_Traffic(t *testing.T) {
+	revision := 2
+	nullTraffic := types.ListNull(types.ObjectType{AttrTypes: serverlessTrafficAttrTypes})
+
+	// The API's default routing is not reported when no traffic is configured.
+	data := ServerlessResourceModel{Traffic: nullTraffic}
+	container := &client.ServerlessContainer{
+		ID:       "srv-123",
+		Revision: 3,
+		Traffic:  []client.ServerlessTrafficTarget{{LatestRevision: true, Percent: 100}},
+	}
+	var diags diag.Diagnostics
+	(&ServerlessResource{}).mapContainerToState(context.Background(), container, &data, &diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected errors: %v", diags)
+	}
+	if !data.Traffic.IsNull() {
+		t.Errorf("traffic = %v, want null", data.Traffic)
+	}
+	if data.Revision.ValueInt64() != 3 {
+		t.Errorf("revision = %v, want 3", data.Revision)
+	}
+	if !data.PinnedRevision.IsNull() {
+		t.Errorf("pinned_revision = %v, want null", data.PinnedRevision)
+	}
+
+	// A split made outside Terraform surfaces as drift.
+	container.Traffic = []client.ServerlessTrafficTarget{
+		{Revision: &revision, Percent: 80},
+		{LatestRevision: true, Percent: 20},
+	}
+	(&ServerlessResource{}).mapContainerToState(context.Background(), container, &data, &diags)
+	if len(data.Traffic.Elements()) != 2 {
+		t.Fatalf("traffic = %v, want 2 targets", data.Traffic)
+	}
+
+	var targets []ServerlessTrafficModel
+	diags.Append(data.Traffic.ElementsAs(context.Background(), &targets, false)...)
+	if diags.HasError() {
+		t.Fatalf("unexpected errors: %v", diags)
+	}
+	if targets[0].Revision.ValueInt64() != 2 || targets[0].LatestRevision.ValueBool() || targets[0].Percent.ValueInt64() != 80 {
+		t.Errorf("traffic[0] = %+v, want revision 2 at 80%%", targets[0])
+	}
+	if !targets[1].Revision.IsNull() || !targets[1].LatestRevision.ValueBool() {
+		t.Errorf("traffic[1] = %+v, want latest revision", targets[1])
+	}
+
+	// A pinned revision receives everything and clears the split.
+	container.Traffic = nil
+	container.PinnedRevision = &revision
+	data.Traffic = nullTraffic
+	(&ServerlessResource{}).mapContainerToState(context.Background(), container, &data, &diags)
+	if data.PinnedRevision.ValueInt64() != 2 {
+		t.Errorf("pinned_revision = %v, want 2", data.PinnedRevision)
+	}
+	if !data.Traffic.IsNull() {
+		t.Errorf("traffic = %v, want null", data.Traffic)
+	}
+}
+
+func TestValidateServerlessScaling(t *testing.T) {
+	healthCheckWith := func(interval, timeout types.Int64) types.Object {
+		return types.ObjectValueMust(serverlessHealthCheckAttrTypes, map[string]attr.Value{
+			"path":                    types.StringValue("/healthz"),
+			"interval_seconds":        interval,
+			"timeout_seconds":         timeout,
+			"failure_threshold":       types.Int64Null(),
+			"startup_timeout_seconds": types.Int64Null(),
+		})
+	}
+	healthCheck := func(interval, timeout int64) types.Object {
+		return healthCheckWith(types.Int64Value(interval), types.Int64Value(timeout))
+	}
+	noHealthCheck := types.ObjectNull(serverlessHealthCheckAttrTypes)
+
+	tests := []struct {
+		name        string
+		min, max    types.Int64
+		healthCheck types.Object
+		errors      int
+	}{
+		{name: "defaults", min: types.Int64Null(), max: types.Int64Null(), healthCheck: noHealthCheck},
+		{name: "equal bounds", min: types.Int64Value(2), max: types.Int64Value(2), healthCheck: noHealthCheck},
+		{name: "min above max", min: types.Int64Value(5), max: types.Int64Value(3), healthCheck: noHealthCheck, errors: 1},
+		{name: "unknown max", min: types.Int64Value(5), max: types.Int64Unknown(), healthCheck: noHealthCheck},
+		{name: "timeout within interval", min: types.Int64Null(), max: types.Int64Null(), healthCheck: healthCheck(10, 10)},
+		{name: "timeout above interval", min: types.Int64Null(), max: types.Int64Null(), healthCheck: healthCheck(5, 10), errors: 1},
+		{name: "timeout above default interval", min: types.Int64Null(), max: types.Int64Null(), healthCheck: healthCheckWith(types.Int64Null(), types.Int64Value(20)), errors: 1},
+		{name: "default timeout above interval", min: types.Int64Null(), max: types.Int64Null(), healthCheck: healthCheckWith(types.Int64Value(3), types.Int64Null()), errors: 1},
+		{name: "default timeout within interval", min: types.Int64Null(), max: types.Int64Null(), healthCheck: healthCheckWith(types.Int64Value(5), types.Int64Null())},
+		{name: "unknown interval", min: types.Int64Null(), max: types.Int64Null(), healthCheck: healthCheckWith(types.Int64Unknown(), types.Int64Value(20))},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := ServerlessResourceModel{MinScale: tt.min, MaxScale: tt.max, HealthCheck: tt.healthCheck}
+			var diags diag.Diagnostics
+			validateServerlessScaling(context.Background(), &data, &diags)
+			if diags.ErrorsCount() != tt.errors {
+				t.Errorf("errors = %d, want %d: %v", diags.ErrorsCount(), tt.errors, diags)
+			}
+		})
+	}
+}
+
+func TestServerlessHealthCheckAndAutoscalingRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	var diags diag.Diagnostics
+
+	healthCheck := &client.ServerlessHealthCheck{Path: "/ready", IntervalSeconds: 15, TimeoutSeconds: 5, FailureThreshold: 4, StartupTimeoutSeconds: 240}
+	if got := expandServerlessHealthCheck(ctx, flattenServerlessHealthCheck(healthCheck, &diags), &diags); got == nil || *got != *healthCheck {
+		t.Errorf("health check round trip = %+v, want %+v", got, healthCheck)
+	}
+
+	autoscaling := &client.ServerlessAutoscaling{TargetConcurrency: 20, ScaleDownDelaySeconds: 900, RequestTimeoutSeconds: 60}
+	if got := expandServerlessAutoscaling(ctx, flattenServerlessAutoscaling(autoscaling, &diags), &diags); got == nil || *got != *autoscaling {
+		t.Errorf("autoscaling round trip = %+v, want %+v", got, autoscaling)
+	}
+
+	if diags.HasError() {
+		t.Fatalf("unexpected errors: %v", diags)
+	}
+	if expandServerlessHealthCheck(ctx, types.ObjectNull(serverlessHealthCheckAttrTypes), &diags) != nil {
+		t.Error("null health_check should expand to nil")
+	}
+}
+
+func TestMapContainerToState_IgnoresUnconfiguredDefaults(t *testing.T) {
+	container := &client.ServerlessContainer{
+		ID:          "srv-123",
+		Autoscaling: &client.ServerlessAutoscaling{TargetConcurrency: 100, ScaleDownDelaySeconds: 300, RequestTimeoutSeconds: 300},
+	}
+	data := ServerlessResourceModel{
+		HealthCheck: types.ObjectNull(serverlessHealthCheckAttrTypes),
+		Autoscaling: types.ObjectNull(serverlessAutoscalingAttrTypes),
+	}
+
+	var diags diag.Diagnostics
+	(&ServerlessResource{}).mapContainerToState(context.Background(), container, &data, &diags)
+	if !data.Autoscaling.IsNull() {
+		t.Errorf("autoscaling = %v, want null when not configured", data.Autoscaling)
+	}
+
+	data.Autoscaling = flattenServerlessAutoscaling(&client.ServerlessAutoscaling{TargetConcurrency: 20}, &diags)
+	(&ServerlessResource{}).mapContainerToState(context.Background(), container, &data, &diags)
+	if got := data.Autoscaling.Attributes()["target_concurrency"]; !got.Equal(types.Int64Value(100)) {
+		t.Errorf("target_concurrency = %v, want drift to 100", got)
+	}
+}
+
+func TestMapContainerToState_DetectsUnconfiguredChanges(t *testing.T) {
+	container := &client.ServerlessContainer{
+		ID:          "srv-123",
+		HealthCheck: &client.ServerlessHealthCheck{Path: "/healthz", IntervalSeconds: 10, TimeoutSeconds: 5, FailureThreshold: 3, StartupTimeoutSeconds: 60},
+		Autoscaling: &client.ServerlessAutoscaling{TargetConcurrency: 20, ScaleDownDelaySeconds: 300, RequestTimeoutSeconds: 300},
+	}
+	data := ServerlessResourceModel{
+		HealthCheck: types.ObjectNull(serverlessHealthCheckAttrTypes),
+		Autoscaling: types.ObjectNull(serverlessAutoscalingAttrTypes),
+	}
+
+	var diags diag.Diagnostics
+	(&ServerlessResource{}).mapContainerToState(context.Background(), container, &data, &diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected errors: %v", diags)
+	}
+	if got := data.HealthCheck.Attributes()["path"]; !got.Equal(types.StringValue("/healthz")) {
+		t.Errorf("health_check.path = %v, want a health check added outside Terraform to be read back", got)
+	}
+	if got := data.Autoscaling.Attributes()["target_concurrency"]; !got.Equal(types.Int64Value(20)) {
+		t.Errorf("target_concurrency = %v, want non-default autoscaling to be read back", got)
+	}
+}
+
+func TestResolveImageDigest(t *testing.T) {
+	const digest = "sha256:4c0e4d5e7d4f4b2a9a8e55b0c2a1f1a3b3e0f7d1c9a6e8b2d5f4a3c2b1e0d9f8"
+	registryServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v2/team/app/manifests/latest" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Docker-Content-Digest", digest)
+		_, _ = w.Write([]byte("{}"))
+	}))
+	defer registryServer.Close()
+
+	r := &ServerlessResource{resolver: registry.NewResolver()}
+	host := strings.TrimPrefix(registryServer.URL, "http://")
+
+	data := ServerlessResourceModel{
+		Image:    types.StringValue(host + "/team/app"),
+		ImageTag: types.StringValue("latest"),
+	}
+	got, err := r.resolveImageDigest(context.Background(), &data)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != digest {
+		t.Errorf("digest = %q, want %q", got, digest)
+	}
+
+	data.ImageTag = types.StringValue("missing")
+	if _, err := r.resolveImageDigest(context.Background(), &data); err == nil {
+		t.Error("expected an error for a tag the registry does not have")
+	}
+
+	data.Image = types.StringNull()
+	if _, err := r.resolveImageDigest(context.Background(), &data); err == nil {
+		t.Error("expected an error without an image")
+	}
+}
+
+func TestMapContainerToState_ImageDigest(t *testing.T) {
+	digest := "sha256:abc"
+
+	tests := []struct {
+		name          string
+		resolveDigest bool
+		apiDigest     *string
+		planned       types.String
+		want          types.String
+	}{
+		{name: "resolved", resolveDigest: true, apiDigest: &digest, planned: types.StringValue("sha256:old"), want: types.StringValue(digest)},
+		{name: "not resolved", resolveDigest: false, apiDigest: &digest, planned: types.StringNull(), want: types.StringNull()},
+		{name: "omitted by the API", resolveDigest: true, planned: types.StringValue("sha256:def"), want: types.StringValue("sha256:def")},
+		{name: "omitted and never resolved", resolveDigest: true, planned: types.StringUnknown(), want: types.StringNull()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			container := &client.ServerlessContainer{ID: "srv-123", ImageTag: "latest", ImageDigest: tt.apiDigest}
+			data := ServerlessResourceModel{
+				ImageDigest:   tt.planned,
+				ResolveDigest: types.BoolValue(tt.resolveDigest),
+				HealthCheck:   types.ObjectNull(serverlessHealthCheckAttrTypes),
+				Autoscaling:   types.ObjectNull(serverlessAutoscalingAttrTypes),
+			}
+			var diags diag.Diagnostics
+			(&ServerlessResource{}).mapContainerToState(context.Background(), container, &data, &diags)
+			if !data.ImageDigest.Equal(tt.want) {
+				t.Errorf("image_digest = %v, want %v", data.ImageDigest, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,397 @@
+package resources
+
+import (
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/moby/patternmatcher"
+	"github.com/moby/patternmatcher/ignorefile"
+)
+
+// sourceArchiveModTime is stamped on every archive entry so that the same
+// directory contents always produce byte-identical archives (the ZIP epoch).
+var sourceArchiveModTime = time.Date(1980, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// loadSourceArchive returns the archive to upload for a zip_upload deployment,
+// together with its hex SHA-256. Exactly one of dir and zipPath is expected to
+// be set: a directory is packed with buildSourceArchive, a ZIP is used as-is.
+func loadSourceArchive(dir, zipPath string) ([]byte, string, error) {
+	var archive []byte
+	var err error
+
+	switch {
+	case dir != "":
+		archive, err = buildSourceArchive(dir)
+	case zipPath != "":
+		archive, err = os.ReadFile(zipPath)
+		if err != nil {
+			err = fmt.Errorf("failed to read source_zip: %w", err)
+		}
+	default:
+		return nil, "", fmt.Errorf("neither source_dir nor source_zip is set")
+	}
+	if err != nil {
+		return nil, "", err
+	}
+
+	sum := sha256.Sum256(archive)
+	return archive, hex.EncodeToString(sum[:]), nil
+}
+
+// sourceArchiveSHA256 returns the hex SHA-256 that loadSourceArchive would
+// return, without holding the archive in memory: a directory's archive is
+// streamed into the hash, a ZIP is read through it.
+func sourceArchiveSHA256(dir, zipPath string) (string, error) {
+	h := sha256.New()
+
+	switch {
+	case dir != "":
+		if err := writeSourceArchive(h, dir); err != nil {
+			return "", err
+		}
+	case zipPath != "":
+		f, err := os.Open(zipPath)
+		if err != nil {
+			return "", fmt.Errorf("failed to read source_zip: %w", err)
+		}
+		defer f.Close()
+		if _, err := io.Copy(h, f); err != nil {
+			return "", fmt.Errorf("failed to read source_zip: %w", err)
+		}
+	default:
+		return "", fmt.Errorf("neither source_dir nor source_zip is set")
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// buildSourceArchive packs a directory into a deterministic ZIP archive: entries
+// are sorted by path, carry a fixed modification time, and only the executable
+// bit of the original mode is kept. Paths excluded by .dockerignore or
+// .gitignore and the .git directory are left out.
+func buildSourceArchive(dir string) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := writeSourceArchive(&buf, dir); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// writeSourceArchive writes the archive buildSourceArchive describes to w.
+func writeSourceArchive(w io.Writer, dir string) error {
+	files, err := listSourceFiles(dir)
+	if err != nil {
+		return err
+	}
+
+	writer := zip.NewWriter(w)
+	for _, rel := range files {
+		if err := addSourceArchiveFile(writer, dir, rel); err != nil {
+			return err
+		}
+	}
+	if err := writer.Close(); err != nil {
+		return fmt.Errorf("failed to finalize source archive: %w", err)
+	}
+	return nil
+}
+
+// listSourceFiles returns the slash-separated paths of the regular files in
+// dir, sorted, skipping the .git directory and paths matched by
+// .dockerignore/.gitignore. A directory with no files left is an error.
+func listSourceFiles(dir string) ([]string, error) {
+	if err := checkSourceDir(dir); err != nil {
+		return nil, err
+	}
+	ignore, err := loadSourceIgnore(dir)
+	if err != nil {
+		return nil, err
+	}
+	files, err := walkSourceFiles(dir, ignore)
+	if err != nil {
+		return nil, err
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("source_dir %s contains no files after applying ignore rules", dir)
+	}
+	return files, nil
+}
+
+// listSiteFiles returns the slash-separated paths of the regular files in
+// dir, sorted, skipping only the .git directory. Site content is built
+// output, so ignore files in it are deployed rather than applied.
+func listSiteFiles(dir string) ([]string, error) {
+	if err := checkSourceDir(dir); err != nil {
+		return nil, err
+	}
+	files, err := walkSourceFiles(dir, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("source_dir %s contains no files", dir)
+	}
+	return files, nil
+}
+
+func checkSourceDir(dir string) error {
+	info, err := os.Stat(dir)
+	if err != nil {
+		return fmt.Errorf("failed to read source_dir: %w", err)
+	}
+	if !info.IsDir() {
+		return fmt.Errorf("source_dir %s is not a directory", dir)
+	}
+	return nil
+}
+
+// walkSourceFiles lists the regular files in dir, sorted, skipping the .git
+// directory and, when ignore is not nil, the paths it excludes.
+func walkSourceFiles(dir string, ignore *sourceIgnore) ([]string, error) {
+	var files []string
+	err := filepath.WalkDir(dir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(dir, p)
+		if err != nil {
+			return err
+		}
+		if rel == "." {
+			return nil
+		}
+		rel = filepath.ToSlash(rel)
+
+		if d.IsDir() {
+			if d.Name() == ".git" || (ignore != nil && ignore.skipDir(rel)) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		if ignore != nil {
+			excluded, err := ignore.excludes(rel)
+			if err != nil || excluded {
+				return err
+			}
+		}
+		files = append(files, rel)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to walk source_dir: %w", err)
+	}
+
+	sort.Strings(files)
+	return files, nil
+}
+
+func addSourceArchiveFile(writer *zip.Writer, dir, rel string) error {
+	full := filepath.Join(dir, filepath.FromSlash(rel))
+	info, err := os.Stat(full)
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", rel, err)
+	}
+
+	mode := fs.FileMode(0o644)
+	if info.Mode()&0o111 != 0 {
+		mode = 0o755
+	}
+
+	header := &zip.FileHeader{
+		Name:     rel,
+		Method:   zip.Deflate,
+		Modified: sourceArchiveModTime,
+	}
+	header.SetMode(mode)
+
+	w, err := writer.CreateHeader(header)
+	if err != nil {
+		return fmt.Errorf("failed to add %s to source archive: %w", rel, err)
+	}
+
+	f, err := os.Open(full)
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", rel, err)
+	}
+	defer f.Close()
+
+	if _, err := io.Copy(w, f); err != nil {
+		return fmt.Errorf("failed to add %s to source archive: %w", rel, err)
+	}
+	return nil
+}
+
+// sourceIgnore decides which paths of a source directory are left out of the
+// archive. A path is excluded when either ignore file excludes it.
+type sourceIgnore struct {
+	// docker holds the .dockerignore patterns, matched the way docker build
+	// matches them: relative to the root, with later "!" patterns able to
+	// re-include files inside an excluded directory.
+	docker *patternmatcher.PatternMatcher
+	git    gitIgnore
+}
+
+func loadSourceIgnore(dir string) (*sourceIgnore, error) {
+	ignore := &sourceIgnore{}
+
+	f, err := os.Open(filepath.Join(dir, ".dockerignore"))
+	switch {
+	case err == nil:
+		patterns, err := ignorefile.ReadAll(f)
+		f.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read .dockerignore: %w", err)
+		}
+		ignore.docker, err = patternmatcher.New(patterns)
+		if err != nil {
+			return nil, fmt.Errorf("invalid pattern in .dockerignore: %w", err)
+		}
+	case !os.IsNotExist(err):
+		return nil, fmt.Errorf("failed to read .dockerignore: %w", err)
+	}
+
+	f, err = os.Open(filepath.Join(dir, ".gitignore"))
+	switch {
+	case err == nil:
+		ignore.git = parseGitIgnore(f)
+		f.Close()
+	case !os.IsNotExist(err):
+		return nil, fmt.Errorf("failed to read .gitignore: %w", err)
+	}
+
+	return ignore, nil
+}
+
+// skipDir reports whether the walk can skip the directory entirely. A
+// directory excluded by .dockerignore is still walked when the file has "!"
+// patterns, since they may re-include something inside it.
+func (s *sourceIgnore) skipDir(rel string) bool {
+	if s.git.matches(rel, true) {
+		return true
+	}
+	if s.docker == nil || s.docker.Exclusions() {
+		return false
+	}
+	matched, err := s.docker.MatchesOrParentMatches(rel)
+	return err == nil && matched
+}
+
+// excludes reports whether the file at the slash-separated relative path is
+// left out of the archive.
+func (s *sourceIgnore) excludes(rel string) (bool, error) {
+	if s.git.matches(rel, false) {
+		return true, nil
+	}
+	if s.docker == nil {
+		return false, nil
+	}
+	matched, err := s.docker.MatchesOrParentMatches(rel)
+	if err != nil {
+		return false, fmt.Errorf("invalid pattern in .dockerignore: %w", err)
+	}
+	return matched, nil
+}
+
+// gitIgnorePattern is a single line of a .gitignore file.
+type gitIgnorePattern struct {
+	pattern  string
+	negate   bool
+	dirOnly  bool
+	anchored bool
+}
+
+// gitIgnore applies .gitignore patterns. Later patterns override earlier
+// ones, and "!" re-includes a previously excluded path. Like git, a file
+// inside an excluded directory cannot be re-included.
+type gitIgnore []gitIgnorePattern
+
+func parseGitIgnore(r io.Reader) gitIgnore {
+	var patterns gitIgnore
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		var p gitIgnorePattern
+		if strings.HasPrefix(line, "!") {
+			p.negate = true
+			line = line[1:]
+		}
+		if strings.HasSuffix(line, "/") {
+			p.dirOnly = true
+			line = strings.TrimSuffix(line, "/")
+		}
+		// A pattern containing a slash is relative to the source root; one
+		// without matches the name at any depth.
+		if strings.Contains(line, "/") {
+			p.anchored = true
+			line = strings.TrimPrefix(line, "/")
+		}
+		if line == "" {
+			continue
+		}
+		p.pattern = line
+		patterns = append(patterns, p)
+	}
+	return patterns
+}
+
+// matches reports whether the slash-separated relative path is excluded.
+func (s gitIgnore) matches(rel string, isDir bool) bool {
+	excluded := false
+	for _, p := range s {
+		if p.dirOnly && !isDir {
+			continue
+		}
+		var ok bool
+		if p.anchored {
+			ok = matchGlobPath(strings.Split(p.pattern, "/"), strings.Split(rel, "/"))
+		} else {
+			ok, _ = path.Match(p.pattern, path.Base(rel))
+		}
+		if ok {
+			excluded = !p.negate
+		}
+	}
+	return excluded
+}
+
+// matchGlobPath matches path segments against pattern segments, where a "**"
+// segment matches zero or more path segments.
+func matchGlobPath(pattern, segments []string) bool {
+	if len(pattern) == 0 {
+		return len(segments) == 0
+	}
+	if pattern[0] == "**" {
+		for i := 0; i <= len(segments); i++ {
+			if matchGlobPath(pattern[1:], segments[i:]) {
+				return true
+			}
+		}
+		return false
+	}
+	if len(segments) == 0 {
+		return false
+	}
+	if ok, _ := path.Match(pattern[0], segments[0]); !ok {
+		return false
+	}
+	return matchGlobPath(pattern[1:], segments[1:])
+}
@@ -0,0 +1,212 @@
+package resources
+
+import (
+	"archive/zip"
+	"bytes"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+)
+
+func writeSourceTree(t *testing.T, files map[string]string) string {
+	t.Helper()
+	dir := t.TempDir()
+	for name, content := range files {
+		full := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(full), 0o755); err != nil {
+			t.Fatalf("mkdir: %v", err)
+		}
+		if err := os.WriteFile(full, []byte(content), 0o644); err != nil {
+			t.Fatalf("write %s: %v", name, err)
+		}
+	}
+	return dir
+}
+
+func archiveEntries(t *testing.T, archive []byte) []string {
+	t.Helper()
+	reader, err := zip.NewReader(bytes.NewReader(archive), int64(len(archive)))
+	if err != nil {
+		t.Fatalf("failed to open archive: %v", err)
+	}
+	names := make([]string, len(reader.File))
+	for i, f := range reader.File {
+		names[i] = f.Name
+	}
+	return names
+}
+
+func TestBuildSourceArchive_Deterministic(t *testing.T) {
+	files := map[string]string{
+		"main.go":        "package main",
+		"Dockerfile":     "FROM scratch",
+		"pkg/util/a.go":  "package util",
+		"pkg/util/b.go":  "package util",
+		"static/app.css": "body{}",
+	}
+	dir := writeSourceTree(t, files)
+
+	_, first, err := loadSourceArchive(dir, "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Touching every file must not change the digest.
+	later := time.Now().Add(48 * time.Hour)
+	for name := range files {
+		if err := os.Chtimes(filepath.Join(dir, name), later, later); err != nil {
+			t.Fatalf("chtimes: %v", err)
+		}
+	}
+
+	_, second, err := loadSourceArchive(dir, "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if first != second {
+		t.Errorf("digest changed after touching files: %s != %s", first, second)
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, "main.go"), []byte("package main // changed"), 0o644); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	_, third, err := loadSourceArchive(dir, "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if third == first {
+		t.Error("digest did not change after editing a file")
+	}
+}
+
+func TestSourceArchiveSHA256_MatchesArchive(t *testing.T) {
+	dir := writeSourceTree(t, map[string]string{
+		"main.go":    "package main",
+		"Dockerfile": "FROM scratch",
+		"web/a.css":  "body{}",
+	})
+	zipPath := filepath.Join(t.TempDir(), "app.zip")
+	if err := os.WriteFile(zipPath, []byte("not really a zip"), 0o644); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+
+	// The plan-time hash must equal the hash of the archive uploaded on apply.
+	for _, src := range []struct{ dir, zip string }{{dir, ""}, {"", zipPath}} {
+		_, want, err := loadSourceArchive(src.dir, src.zip)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		got, err := sourceArchiveSHA256(src.dir, src.zip)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got != want {
+			t.Errorf("sourceArchiveSHA256(%q, %q) = %s, want %s", src.dir, src.zip, got, want)
+		}
+	}
+
+	if _, err := sourceArchiveSHA256(filepath.Join(dir, "missing"), ""); err == nil {
+		t.Error("expected error for a missing source_dir, got nil")
+	}
+}
+
+func TestBuildSourceArchive_SortedEntries(t *testing.T) {
+	dir := writeSourceTree(t, map[string]string{
+		"z.txt":   "z",
+		"a.txt":   "a",
+		"m/b.txt": "b",
+	})
+
+	archive, err := buildSourceArchive(dir)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	names := archiveEntries(t, archive)
+	if !sort.StringsAreSorted(names) {
+		t.Errorf("entries not sorted: %v", names)
+	}
+}
+
+func TestBuildSourceArchive_IgnoreFiles(t *testing.T) {
+	dir := writeSourceTree(t, map[string]string{
+		".dockerignore":         "node_modules/\n*.log\n!keep.log\n",
+		".gitignore":            "# build output\n/dist\ncoverage/**\n",
+		"index.js":              "console.log(1)",
+		"keep.log":              "kept",
+		"debug.log":             "dropped",
+		"node_modules/x/i.js":   "dropped",
+		"lib/node_modules/y.js": "kept",
+		"dist/bundle.js":        "dropped",
+		"src/dist/kept.js":      "kept",
+		"coverage/a/b.out":      "dropped",
+		".git/HEAD":             "dropped",
+	})
+
+	archive, err := buildSourceArchive(dir)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	got := strings.Join(archiveEntries(t, archive), ",")
+	want := ".dockerignore,.gitignore,index.js,keep.log,lib/node_modules/y.js,src/dist/kept.js"
+	if got != want {
+		t.Errorf("entries = %s, want %s", got, want)
+	}
+}
+
+func TestBuildSourceArchive_DockerignoreSemantics(t *testing.T) {
+	dir := writeSourceTree(t, map[string]string{
+		".dockerignore":       "foo\n**/*.tmp\nvendor\n!vendor/keep.txt\n",
+		"foo":                 "dropped",
+		"a/foo":               "kept",
+		"a/b/c.tmp":           "dropped",
+		"vendor/drop.txt":     "dropped",
+		"vendor/keep.txt":     "kept",
+		"vendor/nested/x.txt": "dropped",
+		"src/vendor/kept.txt": "kept",
+		"src/main.go":         "kept",
+	})
+
+	archive, err := buildSourceArchive(dir)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	got := strings.Join(archiveEntries(t, archive), ",")
+	want := ".dockerignore,a/foo,src/main.go,src/vendor/kept.txt,vendor/keep.txt"
+	if got != want {
+		t.Errorf("entries = %s, want %s", got, want)
+	}
+}
+
+func TestBuildSourceArchive_EmptyAfterIgnore(t *testing.T) {
+	dir := writeSourceTree(t, map[string]string{
+		".dockerignore": "*\n",
+	})
+
+	if _, err := buildSourceArchive(dir); err == nil {
+		t.Fatal("expected error for archive with no files, got nil")
+	}
+}
+
+func TestLoadSourceArchive_Zip(t *testing.T) {
+	zipPath := filepath.Join(t.TempDir(), "app.zip")
+	if err := os.WriteFile(zipPath, []byte("not really a zip"), 0o644); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+
+	archive, sum, err := loadSourceArchive("", zipPath)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(archive) != "not really a zip" {
+		t.Errorf("archive was modified: %q", archive)
+	}
+	if len(sum) != 64 {
+		t.Errorf("sha256 = %q, want 64 hex characters", sum)
+	}
+}
@@ -0,0 +1,384 @@
+package resources
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/AdrianSilaghi/terraform-provider-danubedata/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &StaticSiteDeploymentResource{}
+	_ resource.ResourceWithConfigure   = &StaticSiteDeploymentResource{}
+	_ resource.ResourceWithImportState = &StaticSiteDeploymentResource{}
+	_ resource.ResourceWithModifyPlan  = &StaticSiteDeploymentResource{}
+)
+
+type StaticSiteDeploymentResource struct {
+	client *client.Client
+}
+
+type StaticSiteDeploymentResourceModel struct {
+	ID             types.String   `tfsdk:"id"`
+	StaticSiteID   types.String   `tfsdk:"static_site_id"`
+	SourceDir      types.String   `tfsdk:"source_dir"`
+	DeploymentID   types.String   `tfsdk:"deployment_id"`
+	ManifestSHA256 types.String   `tfsdk:"manifest_sha256"`
+	FileCount      types.Int64    `tfsdk:"file_count"`
+	TotalBytes     types.Int64    `tfsdk:"total_bytes"`
+	UploadedFiles  types.Int64    `tfsdk:"uploaded_files"`
+	ActivatedAt    types.String   `tfsdk:"activated_at"`
+	Timeouts       timeouts.Value `tfsdk:"timeouts"`
+}
+
+func NewStaticSiteDeploymentResource() resource.Resource {
+	return &StaticSiteDeploymentResource{}
+}
+
+func (r *StaticSiteDeploymentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_static_site_deployment"
+}
+
+func (r *StaticSiteDeploymentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Deploys the content of a local directory to a DanubeData static site. A per-file SHA-256 manifest is computed at plan time; only files the platform does not already have are uploaded, and the new deployment is activated atomically.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the resource, equal to static_site_id. A site serves one deployment at a time.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"static_site_id": schema.StringAttribute{
+				Description: "ID of the static site to deploy to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"source_dir": schema.StringAttribute{
+				Description: "Local directory with the site content, e.g. the output of a static site generator. Every file is deployed except the .git directory; .dockerignore and .gitignore files are not applied.",
+				Required:    true,
+			},
+			"deployment_id": schema.StringAttribute{
+				Description: "ID of the deployment the site is serving.",
+				Computed:    true,
+			},
+			"manifest_sha256": schema.StringAttribute{
+				Description: "SHA-256 of the deployment manifest: one '<sha256>  <path>' line per file, sorted by path. Computed at plan time from source_dir.",
+				Computed:    true,
+			},
+			"file_count": schema.Int64Attribute{
+				Description: "Number of files in the deployment.",
+				Computed:    true,
+			},
+			"total_bytes": schema.Int64Attribute{
+				Description: "Total size of the deployment's files in bytes.",
+				Computed:    true,
+			},
+			"uploaded_files": schema.Int64Attribute{
+				Description: "Number of files uploaded by the apply that created the deployment; unchanged files are reused and not uploaded again.",
+				Computed:    true,
+			},
+			"activated_at": schema.StringAttribute{
+				Description: "Timestamp when the deployment was activated.",
+				Computed:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+			}),
+		},
+	}
+}
+
+func (r *StaticSiteDeploymentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+	r.client = c
+}
+
+func (r *StaticSiteDeploymentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data StaticSiteDeploymentResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, 30*time.Minute)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	if err := r.deploy(ctx, &data); err != nil {
+		resp.Diagnostics.AddError("Failed to deploy static site", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *StaticSiteDeploymentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data StaticSiteDeploymentResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deployment, err := r.client.GetActiveStaticSiteDeployment(ctx, data.StaticSiteID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Failed to read static site deployment", err.Error())
+		return
+	}
+
+	// A deployment made outside Terraform (e.g. with the CLI) shows up as a
+	// different manifest_sha256, so the next apply restores source_dir.
+	mapStaticSiteDeploymentToState(deployment, &data)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *StaticSiteDeploymentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state StaticSiteDeploymentResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := data.Timeouts.Update(ctx, 30*time.Minute)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Moving source_dir to a directory with identical content is not a new
+	// deployment.
+	if data.ManifestSHA256.Equal(state.ManifestSHA256) {
+		data.DeploymentID = state.DeploymentID
+		data.UploadedFiles = state.UploadedFiles
+		data.ActivatedAt = state.ActivatedAt
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	if err := r.deploy(ctx, &data); err != nil {
+		resp.Diagnostics.AddError("Failed to deploy static site", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *StaticSiteDeploymentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Deployments are immutable history and a site must always serve one, so
+	// there is nothing to delete: the site keeps serving the last deployment.
+	tflog.Info(ctx, "Removing static site deployment from state; the site keeps serving its content")
+}
+
+func (r *StaticSiteDeploymentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("static_site_id"), req.ID)...)
+}
+
+func (r *StaticSiteDeploymentResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan StaticSiteDeploymentResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if plan.SourceDir.IsUnknown() {
+		return
+	}
+
+	manifest, err := buildSiteManifest(plan.SourceDir.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("source_dir"), "Failed to read static site content", err.Error())
+		return
+	}
+
+	sum := siteManifestSHA256(manifest)
+	var totalBytes int64
+	for _, f := range manifest {
+		totalBytes += f.Size
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("manifest_sha256"), types.StringValue(sum))...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("file_count"), types.Int64Value(int64(len(manifest))))...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("total_bytes"), types.Int64Value(totalBytes))...)
+
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	var state StaticSiteDeploymentResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Unchanged content keeps the current deployment, even if source_dir
+	// moved. Changed content leaves the configuration untouched, so the
+	// framework has kept the prior deployment's values; the apply creates a
+	// new one.
+	deploymentID, uploadedFiles, activatedAt := state.DeploymentID, state.UploadedFiles, state.ActivatedAt
+	if state.ManifestSHA256.ValueString() != sum {
+		deploymentID, uploadedFiles, activatedAt = types.StringUnknown(), types.Int64Unknown(), types.StringUnknown()
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("deployment_id"), deploymentID)...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("uploaded_files"), uploadedFiles)...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("activated_at"), activatedAt)...)
+}
+
+// deploy creates a deployment from source_dir, uploads the files the platform
+// is missing and activates it.
+func (r *StaticSiteDeploymentResource) deploy(ctx context.Context, data *StaticSiteDeploymentResourceModel) error {
+	siteID := data.StaticSiteID.ValueString()
+	dir := data.SourceDir.ValueString()
+
+	// Rebuild the manifest: the directory may have changed since the plan,
+	// and the content uploaded must match the manifest sent.
+	manifest, err := buildSiteManifest(dir)
+	if err != nil {
+		return err
+	}
+	if planned := data.ManifestSHA256; !planned.IsUnknown() && planned.ValueString() != siteManifestSHA256(manifest) {
+		return fmt.Errorf("the content of %s changed between plan and apply; run terraform apply again to deploy the current content", dir)
+	}
+
+	deployment, err := r.client.CreateStaticSiteDeployment(ctx, siteID, manifest)
+	if err != nil {
+		return fmt.Errorf("failed to create deployment: %w", err)
+	}
+
+	tflog.Debug(ctx, "Uploading static site files", map[string]interface{}{
+		"static_site_id": siteID,
+		"deployment_id":  deployment.ID,
+		"files":          len(manifest),
+		"missing":        len(deployment.MissingFiles),
+	})
+
+	paths := make(map[string]string, len(manifest))
+	for _, f := range manifest {
+		if _, ok := paths[f.SHA256]; !ok {
+			paths[f.SHA256] = f.Path
+		}
+	}
+	for _, sum := range deployment.MissingFiles {
+		rel, ok := paths[sum]
+		if !ok {
+			return fmt.Errorf("deployment %s requested unknown file %s", deployment.ID, sum)
+		}
+		content, err := os.ReadFile(filepath.Join(dir, filepath.FromSlash(rel)))
+		if err != nil {
+			return fmt.Errorf("failed to read %s: %w", rel, err)
+		}
+		if err := r.client.UploadStaticSiteFile(ctx, siteID, deployment.ID, sum, content); err != nil {
+			return fmt.Errorf("failed to upload %s: %w", rel, err)
+		}
+	}
+
+	activated, err := r.client.ActivateStaticSiteDeployment(ctx, siteID, deployment.ID)
+	if err != nil {
+		return fmt.Errorf("failed to activate deployment %s: %w", deployment.ID, err)
+	}
+
+	mapStaticSiteDeploymentToState(activated, data)
+	data.UploadedFiles = types.Int64Value(int64(len(deployment.MissingFiles)))
+	return nil
+}
+
+func mapStaticSiteDeploymentToState(deployment *client.StaticSiteDeployment, data *StaticSiteDeploymentResourceModel) {
+	data.ID = data.StaticSiteID
+	data.DeploymentID = types.StringValue(deployment.ID)
+	data.ManifestSHA256 = types.StringValue(deployment.ManifestSHA256)
+	data.FileCount = types.Int64Value(int64(deployment.FileCount))
+	data.TotalBytes = types.Int64Value(deployment.TotalBytes)
+	if data.UploadedFiles.IsNull() || data.UploadedFiles.IsUnknown() {
+		data.UploadedFiles = types.Int64Value(0)
+	}
+	if deployment.ActivatedAt != nil {
+		data.ActivatedAt = types.StringValue(*deployment.ActivatedAt)
+	} else {
+		data.ActivatedAt = types.StringNull()
+	}
+}
+
+// buildSiteManifest lists the files of a static site directory with their
+// SHA-256 and size, sorted by path.
+func buildSiteManifest(dir string) ([]client.StaticSiteManifestFile, error) {
+	files, err := listSiteFiles(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	manifest := make([]client.StaticSiteManifestFile, 0, len(files))
+	for _, rel := range files {
+		f, err := os.Open(filepath.Join(dir, filepath.FromSlash(rel)))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", rel, err)
+		}
+		h := sha256.New()
+		size, err := io.Copy(h, f)
+		f.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", rel, err)
+		}
+		manifest = append(manifest, client.StaticSiteManifestFile{
+			Path:   rel,
+			SHA256: hex.EncodeToString(h.Sum(nil)),
+			Size:   size,
+		})
+	}
+	return manifest, nil
+}
+
+// siteManifestSHA256 hashes a manifest written in sha256sum format, one
+// "<sha256>  <path>" line per file. The API computes manifest_sha256 the same
+// way, so a deployment made outside Terraform is detected on refresh.
+func siteManifestSHA256(manifest []client.StaticSiteManifestFile) string {
+	var b strings.Builder
+	for _, f := range manifest {
+		fmt.Fprintf(&b, "%s  %s\n", f.SHA256, f.Path)
+	}
+	sum := sha256.Sum256([]byte(b.String()))
+	return hex.EncodeToString(sum[:])
+}
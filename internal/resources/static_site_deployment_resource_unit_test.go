@@ -0,0 +1,148 @@
+package resources
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/AdrianSilaghi/terraform-provider-danubedata/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestBuildSiteManifest(t *testing.T) {
+	dir := writeSourceTree(t, map[string]string{
+		"index.html":        "<h1>hi</h1>",
+		"assets/app.css":    "body{}",
+		".gitignore":        "*.map\n",
+		"assets/app.js.map": "{}",
+		".git/HEAD":         "ref: refs/heads/main\n",
+	})
+
+	manifest, err := buildSiteManifest(dir)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var paths []string
+	for _, f := range manifest {
+		paths = append(paths, f.Path)
+	}
+	// Built output is deployed as-is: ignore files are not applied.
+	if got, want := strings.Join(paths, ","), ".gitignore,assets/app.css,assets/app.js.map,index.html"; got != want {
+		t.Errorf("paths = %s, want %s", got, want)
+	}
+	sum := sha256.Sum256([]byte("body{}"))
+	if got, want := manifest[1].SHA256, hex.EncodeToString(sum[:]); got != want {
+		t.Errorf("sha256 = %q, want %q", got, want)
+	}
+	if manifest[3].Size != int64(len("<h1>hi</h1>")) {
+		t.Errorf("size = %d, want %d", manifest[3].Size, len("<h1>hi</h1>"))
+	}
+}
+
+func TestSiteManifestSHA256(t *testing.T) {
+	manifest := []client.StaticSiteManifestFile{
+		{Path: "a.html", SHA256: strings.Repeat("a", 64)},
+		{Path: "b.html", SHA256: strings.Repeat("b", 64)},
+	}
+	sum := siteManifestSHA256(manifest)
+	if sum != siteManifestSHA256(manifest) {
+		t.Error("manifest digest is not deterministic")
+	}
+
+	manifest[1].SHA256 = strings.Repeat("c", 64)
+	if siteManifestSHA256(manifest) == sum {
+		t.Error("changing a file did not change the manifest digest")
+	}
+}
+
+func TestStaticSiteDeploymentDeploy_UploadsOnlyMissingFiles(t *testing.T) {
+	dir := writeSourceTree(t, map[string]string{
+		"index.html": "<h1>v2</h1>",
+		"logo.svg":   "<svg/>",
+	})
+	manifest, err := buildSiteManifest(dir)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	changed := manifest[0] // index.html
+
+	var uploaded []string
+	activated := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == "POST" && r.URL.Path == "/static-sites/site-1/deployments":
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"data": client.StaticSiteDeployment{ID: "dep-2", Status: "uploading", MissingFiles: []string{changed.SHA256}},
+			})
+		case r.Method == "PUT" && strings.HasPrefix(r.URL.Path, "/static-sites/site-1/deployments/dep-2/files/"):
+			body, _ := io.ReadAll(r.Body)
+			if string(body) != "<h1>v2</h1>" {
+				t.Errorf("uploaded body = %q, want index.html content", body)
+			}
+			uploaded = append(uploaded, strings.TrimPrefix(r.URL.Path, "/static-sites/site-1/deployments/dep-2/files/"))
+		case r.Method == "POST" && r.URL.Path == "/static-sites/site-1/deployments/dep-2/activate":
+			activated = true
+			activatedAt := "2026-10-18T10:00:00Z"
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"data": client.StaticSiteDeployment{
+					ID: "dep-2", Status: "active", ManifestSHA256: siteManifestSHA256(manifest),
+					FileCount: 2, TotalBytes: 17, ActivatedAt: &activatedAt,
+				},
+			})
+		default:
+			t.Errorf("unexpected %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	r := &StaticSiteDeploymentResource{client: client.New(client.Config{BaseURL: server.URL})}
+	data := StaticSiteDeploymentResourceModel{
+		StaticSiteID:   types.StringValue("site-1"),
+		SourceDir:      types.StringValue(dir),
+		ManifestSHA256: types.StringValue(siteManifestSHA256(manifest)),
+		UploadedFiles:  types.Int64Unknown(),
+	}
+	if err := r.deploy(context.Background(), &data); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(uploaded) != 1 || uploaded[0] != changed.SHA256 {
+		t.Errorf("uploaded = %v, want only %s", uploaded, changed.SHA256)
+	}
+	if !activated {
+		t.Error("deployment was not activated")
+	}
+	if got := data.DeploymentID.ValueString(); got != "dep-2" {
+		t.Errorf("deployment_id = %q, want dep-2", got)
+	}
+	if got := data.UploadedFiles.ValueInt64(); got != 1 {
+		t.Errorf("uploaded_files = %d, want 1", got)
+	}
+	if got := data.ID.ValueString(); got != "site-1" {
+		t.Errorf("id = %q, want site-1", got)
+	}
+}
+
+func TestStaticSiteDeploymentDeploy_ContentChangedSincePlan(t *testing.T) {
+	dir := writeSourceTree(t, map[string]string{"index.html": "<h1>v3</h1>"})
+
+	r := &StaticSiteDeploymentResource{client: client.New(client.Config{BaseURL: "http://127.0.0.1:0"})}
+	data := StaticSiteDeploymentResourceModel{
+		StaticSiteID:   types.StringValue("site-1"),
+		SourceDir:      types.StringValue(dir),
+		ManifestSHA256: types.StringValue(strings.Repeat("0", 64)),
+	}
+	err := r.deploy(context.Background(), &data)
+	if err == nil || !strings.Contains(err.Error(), "changed between plan and apply") {
+		t.Errorf("error = %v, want a changed-since-plan error", err)
+	}
+}
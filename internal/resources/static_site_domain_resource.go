@@ -4,15 +4,19 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/AdrianSilaghi/terraform-provider-danubedata/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -28,16 +32,19 @@ type StaticSiteDomainResource struct {
 }
 
 type StaticSiteDomainResourceModel struct {
-	ID                 types.String `tfsdk:"id"`
-	StaticSiteID       types.String `tfsdk:"static_site_id"`
-	DomainID           types.String `tfsdk:"domain_id"`
-	Domain             types.String `tfsdk:"domain"`
-	VerificationStatus types.String `tfsdk:"verification_status"`
-	TLSStatus          types.String `tfsdk:"tls_status"`
-	DeploymentStatus   types.String `tfsdk:"deployment_status"`
-	IsPrimary          types.Bool   `tfsdk:"is_primary"`
-	DNSInstructions    types.Object `tfsdk:"dns_instructions"`
-	CreatedAt          types.String `tfsdk:"created_at"`
+	ID                  types.String   `tfsdk:"id"`
+	StaticSiteID        types.String   `tfsdk:"static_site_id"`
+	DomainID            types.String   `tfsdk:"domain_id"`
+	Domain              types.String   `tfsdk:"domain"`
+	WaitForVerification types.Bool     `tfsdk:"wait_for_verification"`
+	WaitForTLS          types.Bool     `tfsdk:"wait_for_tls"`
+	VerificationStatus  types.String   `tfsdk:"verification_status"`
+	TLSStatus           types.String   `tfsdk:"tls_status"`
+	DeploymentStatus    types.String   `tfsdk:"deployment_status"`
+	IsPrimary           types.Bool     `tfsdk:"is_primary"`
+	DNSInstructions     types.Object   `tfsdk:"dns_instructions"`
+	CreatedAt           types.String   `tfsdk:"created_at"`
+	Timeouts            timeouts.Value `tfsdk:"timeouts"`
 }
 
 // staticSiteDomainDNSInstructionsAttrTypes describes the object type of the dns_instructions attribute.
@@ -58,7 +65,7 @@ func (r *StaticSiteDomainResource) Metadata(ctx context.Context, req resource.Me
 
 func (r *StaticSiteDomainResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Manages a custom domain attached to a DanubeData static site. After the resource is created the domain is in `pending` verification status; add the DNS record described in `dns_instructions` to prove ownership, then trigger verification out-of-band via `danube pages domains verify` once the record is in place.",
+		Description: "Manages a custom domain attached to a DanubeData static site. After the resource is created the domain is in `pending` verification status; add the DNS record described in `dns_instructions` to prove ownership. With `wait_for_verification` or `wait_for_tls` the apply re-triggers verification until the domain is verified or serving HTTPS; otherwise trigger it out-of-band via `danube pages domains verify` once the record is in place.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Description: "Composite identifier in the form {static_site_id}:{domain_id}.",
@@ -85,6 +92,18 @@ func (r *StaticSiteDomainResource) Schema(ctx context.Context, req resource.Sche
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"wait_for_verification": schema.BoolAttribute{
+				Description: "Wait until DNS ownership of the domain is verified, re-triggering verification while it is pending. Defaults to false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"wait_for_tls": schema.BoolAttribute{
+				Description: "Wait until the domain is verified and its TLS certificate is active. Defaults to false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
 			"verification_status": schema.StringAttribute{
 				Description: "DNS ownership verification status (pending, verifying, verified, failed).",
 				Computed:    true,
@@ -98,7 +117,7 @@ func (r *StaticSiteDomainResource) Schema(ctx context.Context, req resource.Sche
 				Computed:    true,
 			},
 			"is_primary": schema.BoolAttribute{
-				Description: "Whether this is the primary domain for the site.",
+				Description: "Whether this is the primary domain for the site. Choose the primary domain with danubedata_static_site_primary_domain.",
 				Computed:    true,
 			},
 			"dns_instructions": schema.SingleNestedAttribute{
@@ -128,6 +147,12 @@ func (r *StaticSiteDomainResource) Schema(ctx context.Context, req resource.Sche
 				Computed:    true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+			}),
+		},
 	}
 }
 
@@ -153,6 +178,12 @@ func (r *StaticSiteDomainResource) Create(ctx context.Context, req resource.Crea
 		return
 	}
 
+	createTimeout, diags := data.Timeouts.Create(ctx, 30*time.Minute)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	siteID := data.StaticSiteID.ValueString()
 	tflog.Debug(ctx, "Adding static site domain", map[string]interface{}{
 		"static_site_id": siteID,
@@ -169,6 +200,11 @@ func (r *StaticSiteDomainResource) Create(ctx context.Context, req resource.Crea
 
 	r.mapDomainToState(siteID, domain, &data, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.waitForDomain(ctx, &data, createTimeout, &resp.State, &resp.Diagnostics)
 }
 
 func (r *StaticSiteDomainResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -194,14 +230,31 @@ func (r *StaticSiteDomainResource) Read(ctx context.Context, req resource.ReadRe
 }
 
 func (r *StaticSiteDomainResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// Domain attachments are immutable; all user-visible fields require replacement.
-	// Preserve existing state rather than writing the plan.
-	var data StaticSiteDomainResourceModel
+	// Domain attachments are immutable; only the wait flags and timeouts can
+	// change in place. Keep the attachment's state and wait if a flag was
+	// turned on.
+	var data, plan StaticSiteDomainResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, 30*time.Minute)
+	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	data.WaitForVerification = plan.WaitForVerification
+	data.WaitForTLS = plan.WaitForTLS
+	data.Timeouts = plan.Timeouts
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.waitForDomain(ctx, &data, updateTimeout, &resp.State, &resp.Diagnostics)
 }
 
 func (r *StaticSiteDomainResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -238,6 +291,28 @@ func (r *StaticSiteDomainResource) ImportState(ctx context.Context, req resource
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("static_site_id"), parts[0])...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("domain"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("wait_for_verification"), false)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("wait_for_tls"), false)...)
+}
+
+// waitForDomain waits for verification and TLS as configured. State has
+// already been saved, so a failed wait leaves the domain tainted rather than
+// untracked.
+func (r *StaticSiteDomainResource) waitForDomain(ctx context.Context, data *StaticSiteDomainResourceModel, timeout time.Duration, state *tfsdk.State, diags *diag.Diagnostics) {
+	waitForTLS := data.WaitForTLS.ValueBool()
+	if !waitForTLS && !data.WaitForVerification.ValueBool() {
+		return
+	}
+
+	siteID := data.StaticSiteID.ValueString()
+	domain, err := r.client.WaitForStaticSiteDomain(ctx, siteID, data.Domain.ValueString(), waitForTLS, timeout)
+	if domain != nil {
+		r.mapDomainToState(siteID, domain, data, diags)
+		diags.Append(state.Set(ctx, data)...)
+	}
+	if err != nil {
+		diags.AddError("Static site domain is not ready", err.Error())
+	}
 }
 
 func (r *StaticSiteDomainResource) mapDomainToState(siteID string, domain *client.StaticSiteDomain, data *StaticSiteDomainResourceModel, diags *diag.Diagnostics) {
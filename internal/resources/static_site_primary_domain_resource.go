@@ -0,0 +1,253 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/AdrianSilaghi/terraform-provider-danubedata/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &StaticSitePrimaryDomainResource{}
+	_ resource.ResourceWithConfigure   = &StaticSitePrimaryDomainResource{}
+	_ resource.ResourceWithImportState = &StaticSitePrimaryDomainResource{}
+)
+
+// StaticSitePrimaryDomainResource selects the primary domain of a static site.
+// A site has exactly one primary domain, so the choice lives in a single
+// resource per site rather than on each domain: two domains can never both be
+// configured as primary, and moving the role is one in-place update.
+type StaticSitePrimaryDomainResource struct {
+	client *client.Client
+}
+
+type StaticSitePrimaryDomainResourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	StaticSiteID types.String `tfsdk:"static_site_id"`
+	Domain       types.String `tfsdk:"domain"`
+	DomainID     types.String `tfsdk:"domain_id"`
+}
+
+func NewStaticSitePrimaryDomainResource() resource.Resource {
+	return &StaticSitePrimaryDomainResource{}
+}
+
+func (r *StaticSitePrimaryDomainResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_static_site_primary_domain"
+}
+
+func (r *StaticSitePrimaryDomainResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Selects the primary domain of a static site. The domain must already be attached with danubedata_static_site_domain. Destroying this resource makes the site's default domain primary again.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Same as static_site_id.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"static_site_id": schema.StringAttribute{
+				Description: "ID of the static site.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"domain": schema.StringAttribute{
+				Description: "Custom domain to make primary. Reference the domain attribute of a danubedata_static_site_domain so the domain is attached first. Changing it moves the primary role in place.",
+				Required:    true,
+			},
+			"domain_id": schema.StringAttribute{
+				Description: "ID of the primary domain.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *StaticSitePrimaryDomainResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+	r.client = c
+}
+
+func (r *StaticSitePrimaryDomainResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data StaticSitePrimaryDomainResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A custom primary domain set by another resource, or outside Terraform,
+	// is not taken over silently: two resources for one site would move the
+	// role back and forth on every apply.
+	if err := r.checkPrimaryUnclaimed(ctx, &data); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("static_site_id"), "Static Site Already Has a Primary Domain", err.Error())
+		return
+	}
+
+	if err := r.setPrimary(ctx, &data); err != nil {
+		resp.Diagnostics.AddError("Failed to set the primary domain of the static site", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *StaticSitePrimaryDomainResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data StaticSitePrimaryDomainResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	primary, err := primaryCustomDomain(ctx, r.client, data.StaticSiteID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Failed to read the primary domain of the static site", err.Error())
+		return
+	}
+	if primary == nil {
+		// The default domain is primary again, e.g. because the custom
+		// domain was detached; the next apply selects it anew.
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.ID = data.StaticSiteID
+	data.Domain = types.StringValue(primary.Domain)
+	data.DomainID = types.StringValue(primary.ID)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *StaticSitePrimaryDomainResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data StaticSitePrimaryDomainResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Making the new domain primary demotes the previous one in the same call.
+	if err := r.setPrimary(ctx, &data); err != nil {
+		resp.Diagnostics.AddError("Failed to set the primary domain of the static site", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *StaticSitePrimaryDomainResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data StaticSitePrimaryDomainResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	siteID := data.StaticSiteID.ValueString()
+	tflog.Debug(ctx, "Reverting static site to its default primary domain", map[string]interface{}{
+		"static_site_id": siteID,
+		"domain":         data.Domain.ValueString(),
+	})
+
+	_, err := r.client.UpdateStaticSiteDomain(ctx, siteID, data.DomainID.ValueString(), client.UpdateStaticSiteDomainRequest{IsPrimary: false})
+	if err != nil {
+		if client.IsNotFound(err) {
+			// The domain or the whole site is gone, taking the role with it.
+			return
+		}
+		resp.Diagnostics.AddError("Failed to revert the primary domain of the static site", err.Error())
+		return
+	}
+}
+
+func (r *StaticSitePrimaryDomainResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// The ID is the static site ID; Read fills in the current primary domain.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("static_site_id"), req.ID)...)
+}
+
+// setPrimary makes data.Domain the site's primary domain and fills in the
+// computed attributes.
+func (r *StaticSitePrimaryDomainResource) setPrimary(ctx context.Context, data *StaticSitePrimaryDomainResourceModel) error {
+	siteID := data.StaticSiteID.ValueString()
+	domain, err := r.client.FindStaticSiteDomain(ctx, siteID, data.Domain.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			return fmt.Errorf("%s is not attached to static site %s. Attach it with danubedata_static_site_domain and reference that resource's domain attribute here, so it is attached first", data.Domain.ValueString(), siteID)
+		}
+		return err
+	}
+
+	tflog.Debug(ctx, "Setting static site primary domain", map[string]interface{}{
+		"static_site_id": siteID,
+		"domain":         domain.Domain,
+	})
+
+	domain, err = r.client.UpdateStaticSiteDomain(ctx, siteID, domain.ID, client.UpdateStaticSiteDomainRequest{IsPrimary: true})
+	if err != nil {
+		return err
+	}
+
+	data.ID = types.StringValue(siteID)
+	data.DomainID = types.StringValue(domain.ID)
+	return nil
+}
+
+// checkPrimaryUnclaimed returns an error if a custom domain other than
+// data.Domain is already primary for the site.
+func (r *StaticSitePrimaryDomainResource) checkPrimaryUnclaimed(ctx context.Context, data *StaticSitePrimaryDomainResourceModel) error {
+	siteID := data.StaticSiteID.ValueString()
+	primary, err := primaryCustomDomain(ctx, r.client, siteID)
+	if err != nil {
+		return err
+	}
+	if primary != nil && primary.Domain != data.Domain.ValueString() {
+		return fmt.Errorf("%s is already the primary domain of static site %s. A site can have only one danubedata_static_site_primary_domain; "+
+			"change the domain of the existing resource, or import it with terraform import using the site ID", primary.Domain, siteID)
+	}
+	return nil
+}
+
+// primaryCustomDomain returns the custom domain that is primary for the site,
+// or nil if the site's default domain is. The default domain may be listed
+// among the site's domains, but it is only primary until a custom domain is.
+func primaryCustomDomain(ctx context.Context, c *client.Client, siteID string) (*client.StaticSiteDomain, error) {
+	site, err := c.GetStaticSite(ctx, siteID)
+	if err != nil {
+		return nil, err
+	}
+	defaultDomain := ""
+	if u, err := url.Parse(site.URL); err == nil {
+		defaultDomain = u.Hostname()
+	}
+
+	domains, err := c.ListStaticSiteDomains(ctx, siteID)
+	if err != nil {
+		return nil, err
+	}
+	for i := range domains {
+		if domains[i].IsPrimary && domains[i].Domain != defaultDomain {
+			return &domains[i], nil
+		}
+	}
+	return nil, nil
+}
@@ -0,0 +1,166 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/AdrianSilaghi/terraform-provider-danubedata/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// newStaticSiteDomainsServer serves a site whose default domain is
+// site-1.pages.example and records which domain was last made primary.
+func newStaticSiteDomainsServer(t *testing.T, domains []client.StaticSiteDomain) (*httptest.Server, *[]string) {
+	t.Helper()
+	var updates []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/static-sites/site-1":
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"data": client.StaticSite{ID: "site-1", URL: "https://site-1.pages.example"},
+			})
+		case r.Method == "GET" && r.URL.Path == "/static-sites/site-1/domains":
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"data": domains})
+		case r.Method == "PUT" && strings.HasPrefix(r.URL.Path, "/static-sites/site-1/domains/"):
+			var req client.UpdateStaticSiteDomainRequest
+			_ = json.NewDecoder(r.Body).Decode(&req)
+			id := strings.TrimPrefix(r.URL.Path, "/static-sites/site-1/domains/")
+			if req.IsPrimary {
+				updates = append(updates, id)
+			}
+			for _, d := range domains {
+				if d.ID == id {
+					d.IsPrimary = req.IsPrimary
+					_ = json.NewEncoder(w).Encode(map[string]interface{}{"data": d})
+					return
+				}
+			}
+			w.WriteHeader(http.StatusNotFound)
+		default:
+			t.Errorf("unexpected %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server, &updates
+}
+
+func TestPrimaryCustomDomain(t *testing.T) {
+	tests := []struct {
+		name    string
+		domains []client.StaticSiteDomain
+		want    string
+	}{
+		{
+			name: "custom domain is primary",
+			domains: []client.StaticSiteDomain{
+				{ID: "d-0", Domain: "site-1.pages.example"},
+				{ID: "d-1", Domain: "example.com"},
+				{ID: "d-2", Domain: "www.example.com", IsPrimary: true},
+			},
+			want: "www.example.com",
+		},
+		{
+			name: "default domain is primary",
+			domains: []client.StaticSiteDomain{
+				{ID: "d-0", Domain: "site-1.pages.example", IsPrimary: true},
+				{ID: "d-1", Domain: "example.com"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, _ := newStaticSiteDomainsServer(t, tt.domains)
+			primary, err := primaryCustomDomain(context.Background(), client.New(client.Config{BaseURL: server.URL}), "site-1")
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			got := ""
+			if primary != nil {
+				got = primary.Domain
+			}
+			if got != tt.want {
+				t.Errorf("primary = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStaticSitePrimaryDomainSetPrimary(t *testing.T) {
+	server, updates := newStaticSiteDomainsServer(t, []client.StaticSiteDomain{
+		{ID: "d-1", Domain: "example.com", IsPrimary: true},
+		{ID: "d-2", Domain: "www.example.com"},
+	})
+	r := &StaticSitePrimaryDomainResource{client: client.New(client.Config{BaseURL: server.URL})}
+
+	data := StaticSitePrimaryDomainResourceModel{
+		StaticSiteID: types.StringValue("site-1"),
+		Domain:       types.StringValue("www.example.com"),
+	}
+	if err := r.setPrimary(context.Background(), &data); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(*updates) != 1 || (*updates)[0] != "d-2" {
+		t.Errorf("promoted = %v, want only d-2", *updates)
+	}
+	if data.ID.ValueString() != "site-1" || data.DomainID.ValueString() != "d-2" {
+		t.Errorf("id = %v, domain_id = %v, want site-1 and d-2", data.ID, data.DomainID)
+	}
+
+	data.Domain = types.StringValue("shop.example.com")
+	err := r.setPrimary(context.Background(), &data)
+	if err == nil || !strings.Contains(err.Error(), "is not attached") {
+		t.Errorf("error = %v, want it to say the domain is not attached", err)
+	}
+}
+
+func TestStaticSitePrimaryDomainCheckPrimaryUnclaimed(t *testing.T) {
+	tests := []struct {
+		name    string
+		domains []client.StaticSiteDomain
+		wantErr bool
+	}{
+		{
+			name: "default domain is primary",
+			domains: []client.StaticSiteDomain{
+				{ID: "d-0", Domain: "site-1.pages.example", IsPrimary: true},
+				{ID: "d-2", Domain: "www.example.com"},
+			},
+		},
+		{
+			name: "planned domain is already primary",
+			domains: []client.StaticSiteDomain{
+				{ID: "d-2", Domain: "www.example.com", IsPrimary: true},
+			},
+		},
+		{
+			name: "another custom domain is primary",
+			domains: []client.StaticSiteDomain{
+				{ID: "d-1", Domain: "example.com", IsPrimary: true},
+				{ID: "d-2", Domain: "www.example.com"},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, _ := newStaticSiteDomainsServer(t, tt.domains)
+			r := &StaticSitePrimaryDomainResource{client: client.New(client.Config{BaseURL: server.URL})}
+			data := StaticSitePrimaryDomainResourceModel{
+				StaticSiteID: types.StringValue("site-1"),
+				Domain:       types.StringValue("www.example.com"),
+			}
+			err := r.checkPrimaryUnclaimed(context.Background(), &data)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("checkPrimaryUnclaimed() error = %v, want error %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -3,22 +3,35 @@ package resources
 import (
 	"context"
 	"fmt"
+	"regexp"
+	"strings"
 
 	"github.com/AdrianSilaghi/terraform-provider-danubedata/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 var (
-	_ resource.Resource                = &StaticSiteResource{}
-	_ resource.ResourceWithConfigure   = &StaticSiteResource{}
-	_ resource.ResourceWithImportState = &StaticSiteResource{}
+	_ resource.Resource                   = &StaticSiteResource{}
+	_ resource.ResourceWithConfigure      = &StaticSiteResource{}
+	_ resource.ResourceWithImportState    = &StaticSiteResource{}
+	_ resource.ResourceWithValidateConfig = &StaticSiteResource{}
 )
 
 type StaticSiteResource struct {
@@ -26,16 +39,58 @@ type StaticSiteResource struct {
 }
 
 type StaticSiteResourceModel struct {
-	ID        types.String `tfsdk:"id"`
-	Name      types.String `tfsdk:"name"`
-	Slug      types.String `tfsdk:"slug"`
-	URL       types.String `tfsdk:"url"`
-	Plan      types.String `tfsdk:"plan"`
-	Status    types.String `tfsdk:"status"`
-	CreatedAt types.String `tfsdk:"created_at"`
-	UpdatedAt types.String `tfsdk:"updated_at"`
+	ID                types.String `tfsdk:"id"`
+	Name              types.String `tfsdk:"name"`
+	Slug              types.String `tfsdk:"slug"`
+	URL               types.String `tfsdk:"url"`
+	Plan              types.String `tfsdk:"plan"`
+	RedirectToPrimary types.Bool   `tfsdk:"redirect_to_primary"`
+	Redirects         types.List   `tfsdk:"redirects"`
+	Headers           types.List   `tfsdk:"headers"`
+	BasicAuth         types.Object `tfsdk:"basic_auth"`
+	Status            types.String `tfsdk:"status"`
+	CreatedAt         types.String `tfsdk:"created_at"`
+	UpdatedAt         types.String `tfsdk:"updated_at"`
 }
 
+type StaticSiteRedirectModel struct {
+	From   types.String `tfsdk:"from"`
+	To     types.String `tfsdk:"to"`
+	Status types.Int64  `tfsdk:"status"`
+}
+
+// staticSiteRedirectAttrTypes describes the object type of an element of the redirects attribute.
+var staticSiteRedirectAttrTypes = map[string]attr.Type{
+	"from":   types.StringType,
+	"to":     types.StringType,
+	"status": types.Int64Type,
+}
+
+type StaticSiteHeaderRuleModel struct {
+	Path   types.String `tfsdk:"path"`
+	Values types.Map    `tfsdk:"values"`
+}
+
+// staticSiteHeaderRuleAttrTypes describes the object type of an element of the headers attribute.
+var staticSiteHeaderRuleAttrTypes = map[string]attr.Type{
+	"path":   types.StringType,
+	"values": types.MapType{ElemType: types.StringType},
+}
+
+type StaticSiteBasicAuthModel struct {
+	Username types.String `tfsdk:"username"`
+	Password types.String `tfsdk:"password"`
+}
+
+// staticSiteBasicAuthAttrTypes describes the object type of the basic_auth attribute.
+var staticSiteBasicAuthAttrTypes = map[string]attr.Type{
+	"username": types.StringType,
+	"password": types.StringType,
+}
+
+// httpHeaderName matches a valid HTTP header field name (an RFC 9110 token).
+var httpHeaderName = regexp.MustCompile("^[!#$%&'*+.^_`|~0-9A-Za-z-]+$")
+
 func NewStaticSiteResource() resource.Resource {
 	return &StaticSiteResource{}
 }
@@ -46,7 +101,7 @@ func (r *StaticSiteResource) Metadata(ctx context.Context, req resource.Metadata
 
 func (r *StaticSiteResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Manages a DanubeData static site (pages). Deployments are triggered out-of-band via the CLI or CI/CD; this resource manages only the site container, not its content.",
+		Description: "Manages a DanubeData static site (pages). This resource manages only the site container, not its content; deploy content with danubedata_static_site_deployment, or out-of-band via the CLI or CI/CD.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Description: "Unique identifier for the static site.",
@@ -56,27 +111,120 @@ func (r *StaticSiteResource) Schema(ctx context.Context, req resource.SchemaRequ
 				},
 			},
 			"name": schema.StringAttribute{
-				Description: "Name of the static site.",
+				Description: "Name of the static site. Can be changed in place; the slug and URL are kept.",
 				Required:    true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
 			},
 			"slug": schema.StringAttribute{
 				Description: "URL slug for the site.",
 				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"url": schema.StringAttribute{
 				Description: "Default URL of the deployed site.",
 				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"plan": schema.StringAttribute{
-				Description: "Pricing plan for the site (free, starter, pro). Defaults to free if omitted. Changing this requires replacement; there is no update endpoint.",
+				Description: "Pricing plan for the site (free, starter, pro). Defaults to free if omitted. Can be changed in place; domains and deployments are kept.",
 				Optional:    true,
 				Computed:    true,
 				Default:     stringdefault.StaticString("free"),
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+				Validators: []validator.String{
+					stringvalidator.OneOf("free", "starter", "pro"),
+				},
+			},
+			"redirect_to_primary": schema.BoolAttribute{
+				Description: "Redirect requests on every other domain of the site, including the default one, to the primary domain with a permanent (301) redirect. The primary domain is chosen with danubedata_static_site_primary_domain. Defaults to false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"redirects": schema.ListNestedAttribute{
+				Description: "Redirect rules, evaluated in order; the first rule whose from pattern matches the request path wins.",
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"from": schema.StringAttribute{
+							Description: "Path pattern to match, e.g. '/blog/*'. A single * matches within one path segment, ** across segments.",
+							Required:    true,
+							Validators: []validator.String{
+								pathGlob(),
+							},
+						},
+						"to": schema.StringAttribute{
+							Description: "Target path or absolute http(s) URL. :splat is replaced by the part of the path matched by the first wildcard of from, e.g. '/articles/:splat'.",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(regexp.MustCompile(`^(/|https?://)\S*$`), "must be a path starting with '/' or an absolute http(s) URL, without whitespace"),
+							},
+						},
+						"status": schema.Int64Attribute{
+							Description: "HTTP status code of the redirect: 301 or 308 (permanent), 302 or 307 (temporary). Defaults to 301.",
+							Optional:    true,
+							Computed:    true,
+							Default:     int64default.StaticInt64(301),
+							Validators: []validator.Int64{
+								int64validator.OneOf(301, 302, 307, 308),
+							},
+						},
+					},
+				},
+			},
+			"headers": schema.ListNestedAttribute{
+				Description: "Custom response headers per path pattern, e.g. a Content-Security-Policy for every page or Cache-Control for '/assets/**'. When several rules set the same header for a path, the last one wins.",
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"path": schema.StringAttribute{
+							Description: "Path pattern the headers apply to, e.g. '/*' or '/assets/**'. A single * matches within one path segment, ** across segments.",
+							Required:    true,
+							Validators: []validator.String{
+								pathGlob(),
+							},
+						},
+						"values": schema.MapAttribute{
+							Description: "Header names and values to set on matching responses.",
+							Required:    true,
+							ElementType: types.StringType,
+							Validators: []validator.Map{
+								mapvalidator.SizeAtLeast(1),
+								mapvalidator.KeysAre(
+									stringvalidator.RegexMatches(httpHeaderName, "must be a valid HTTP header name"),
+								),
+							},
+						},
+					},
+				},
+			},
+			"basic_auth": schema.SingleNestedAttribute{
+				Description: "Protects the whole site, on every domain, with HTTP basic authentication, e.g. for preview sites. Remove to make the site public again.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"username": schema.StringAttribute{
+						Description: "Username visitors must enter.",
+						Required:    true,
+						Validators: []validator.String{
+							stringvalidator.RegexMatches(regexp.MustCompile(`^[^:]+$`), "must be non-empty and must not contain ':'"),
+						},
+					},
+					"password": schema.StringAttribute{
+						Description: "Password visitors must enter, at least 8 characters. The API never returns it, so changes made outside Terraform are not detected.",
+						Required:    true,
+						Sensitive:   true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(8),
+						},
+					},
 				},
 			},
 			"status": schema.StringAttribute{
@@ -86,6 +234,9 @@ func (r *StaticSiteResource) Schema(ctx context.Context, req resource.SchemaRequ
 			"created_at": schema.StringAttribute{
 				Description: "Timestamp when the site was created.",
 				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"updated_at": schema.StringAttribute{
 				Description: "Timestamp when the site was last updated.",
@@ -132,7 +283,44 @@ func (r *StaticSiteResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
-	r.mapSiteToState(site, &data)
+	// Redirects, headers and basic auth can only be configured once the site
+	// exists. Record the site as created so far after each step, so that a
+	// failure leaves it tainted in state rather than untracked. data keeps the
+	// plan the remaining steps are configured from.
+	created := data
+	r.mapSiteToState(ctx, site, &created, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &created)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.RedirectToPrimary.ValueBool() {
+		redirect := true
+		site, err = r.client.UpdateStaticSite(ctx, site.ID, client.UpdateStaticSiteRequest{RedirectToPrimary: &redirect})
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to configure static site redirects", err.Error())
+			return
+		}
+		r.mapSiteToState(ctx, site, &created, &resp.Diagnostics)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &created)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if !data.Redirects.IsNull() || !data.Headers.IsNull() || !data.BasicAuth.IsNull() {
+		config := expandStaticSiteConfig(ctx, &data, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		site, err = r.client.SetStaticSiteConfig(ctx, site.ID, config)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to configure static site redirects, headers and basic auth", err.Error())
+			return
+		}
+	}
+
+	r.mapSiteToState(ctx, site, &data, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -153,19 +341,65 @@ func (r *StaticSiteResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
-	r.mapSiteToState(site, &data)
+	r.mapSiteToState(ctx, site, &data, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *StaticSiteResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// Static sites are immutable through this API; all user-visible fields require
-	// replacement. Preserve existing state rather than writing the plan (which may
-	// contain Unknown computed fields).
-	var data StaticSiteResourceModel
-	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	var data, state StaticSiteResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	updateReq := client.UpdateStaticSiteRequest{}
+	hasChanges := false
+
+	if !data.Name.Equal(state.Name) {
+		name := data.Name.ValueString()
+		updateReq.Name = &name
+		hasChanges = true
+	}
+	if !data.Plan.Equal(state.Plan) {
+		plan := data.Plan.ValueString()
+		updateReq.Plan = &plan
+		hasChanges = true
+	}
+	if !data.RedirectToPrimary.Equal(state.RedirectToPrimary) {
+		redirect := data.RedirectToPrimary.ValueBool()
+		updateReq.RedirectToPrimary = &redirect
+		hasChanges = true
+	}
+
+	var site *client.StaticSite
+	var err error
+	if hasChanges {
+		tflog.Debug(ctx, "Updating static site", map[string]interface{}{
+			"id": state.ID.ValueString(),
+		})
+		site, err = r.client.UpdateStaticSite(ctx, state.ID.ValueString(), updateReq)
+	} else {
+		site, err = r.client.GetStaticSite(ctx, state.ID.ValueString())
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update static site", err.Error())
+		return
+	}
+
+	if !data.Redirects.Equal(state.Redirects) || !data.Headers.Equal(state.Headers) || !data.BasicAuth.Equal(state.BasicAuth) {
+		config := expandStaticSiteConfig(ctx, &data, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		site, err = r.client.SetStaticSiteConfig(ctx, state.ID.ValueString(), config)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to update static site redirects, headers and basic auth", err.Error())
+			return
+		}
+	}
+
+	r.mapSiteToState(ctx, site, &data, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -189,12 +423,178 @@ func (r *StaticSiteResource) ImportState(ctx context.Context, req resource.Impor
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
-func (r *StaticSiteResource) mapSiteToState(site *client.StaticSite, data *StaticSiteResourceModel) {
+func (r *StaticSiteResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data StaticSiteResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	validateStaticSiteRedirects(ctx, data.Redirects, &resp.Diagnostics)
+}
+
+// validateStaticSiteRedirects rejects redirect rules that can never apply or
+// that redirect to themselves.
+func validateStaticSiteRedirects(ctx context.Context, redirects types.List, diags *diag.Diagnostics) {
+	if redirects.IsNull() || redirects.IsUnknown() {
+		return
+	}
+
+	var rules []StaticSiteRedirectModel
+	diags.Append(redirects.ElementsAs(ctx, &rules, false)...)
+	if diags.HasError() {
+		return
+	}
+
+	seen := make(map[string]int)
+	for i, rule := range rules {
+		if rule.From.IsUnknown() || rule.To.IsUnknown() {
+			continue
+		}
+		from, to := rule.From.ValueString(), rule.To.ValueString()
+		attrPath := path.Root("redirects").AtListIndex(i)
+
+		if first, ok := seen[from]; ok {
+			diags.AddAttributeError(attrPath.AtName("from"), "Duplicate Redirect",
+				fmt.Sprintf("%q is already matched by redirect %d; only the first matching rule applies.", from, first))
+			continue
+		}
+		seen[from] = i
+
+		hasWildcard := strings.Contains(from, "*")
+		if strings.Contains(to, ":splat") && !hasWildcard {
+			diags.AddAttributeError(attrPath.AtName("to"), "Invalid Redirect Target",
+				fmt.Sprintf("%q uses :splat, but from %q has no wildcard to take it from.", to, from))
+		}
+		if !hasWildcard && from == to {
+			diags.AddAttributeError(attrPath.AtName("to"), "Redirect Loop",
+				fmt.Sprintf("Redirect %d sends %q to itself.", i, from))
+		}
+	}
+}
+
+// expandStaticSiteConfig converts the redirects, headers and basic_auth
+// attributes into their API form. Unset lists become empty ones, so that the
+// API clears them.
+func expandStaticSiteConfig(ctx context.Context, data *StaticSiteResourceModel, diags *diag.Diagnostics) client.StaticSiteConfig {
+	config := client.StaticSiteConfig{
+		Redirects: []client.StaticSiteRedirect{},
+		Headers:   []client.StaticSiteHeaderRule{},
+	}
+
+	if !data.Redirects.IsNull() && !data.Redirects.IsUnknown() {
+		var redirects []StaticSiteRedirectModel
+		diags.Append(data.Redirects.ElementsAs(ctx, &redirects, false)...)
+		for _, redirect := range redirects {
+			config.Redirects = append(config.Redirects, client.StaticSiteRedirect{
+				From:   redirect.From.ValueString(),
+				To:     redirect.To.ValueString(),
+				Status: int(redirect.Status.ValueInt64()),
+			})
+		}
+	}
+
+	if !data.Headers.IsNull() && !data.Headers.IsUnknown() {
+		var rules []StaticSiteHeaderRuleModel
+		diags.Append(data.Headers.ElementsAs(ctx, &rules, false)...)
+		for _, rule := range rules {
+			values := make(map[string]string)
+			diags.Append(rule.Values.ElementsAs(ctx, &values, false)...)
+			config.Headers = append(config.Headers, client.StaticSiteHeaderRule{
+				Path:   rule.Path.ValueString(),
+				Values: values,
+			})
+		}
+	}
+
+	if !data.BasicAuth.IsNull() && !data.BasicAuth.IsUnknown() {
+		var basicAuth StaticSiteBasicAuthModel
+		diags.Append(data.BasicAuth.As(ctx, &basicAuth, basetypes.ObjectAsOptions{})...)
+		config.BasicAuth = &client.StaticSiteBasicAuth{
+			Username: basicAuth.Username.ValueString(),
+			Password: basicAuth.Password.ValueString(),
+		}
+	}
+
+	return config
+}
+
+func flattenStaticSiteRedirects(redirects []client.StaticSiteRedirect, diags *diag.Diagnostics) types.List {
+	elems := make([]attr.Value, 0, len(redirects))
+	for _, redirect := range redirects {
+		obj, objDiags := types.ObjectValue(staticSiteRedirectAttrTypes, map[string]attr.Value{
+			"from":   types.StringValue(redirect.From),
+			"to":     types.StringValue(redirect.To),
+			"status": types.Int64Value(int64(redirect.Status)),
+		})
+		diags.Append(objDiags...)
+		elems = append(elems, obj)
+	}
+	list, listDiags := types.ListValue(types.ObjectType{AttrTypes: staticSiteRedirectAttrTypes}, elems)
+	diags.Append(listDiags...)
+	return list
+}
+
+func flattenStaticSiteHeaders(ctx context.Context, rules []client.StaticSiteHeaderRule, diags *diag.Diagnostics) types.List {
+	elems := make([]attr.Value, 0, len(rules))
+	for _, rule := range rules {
+		values, mapDiags := types.MapValueFrom(ctx, types.StringType, rule.Values)
+		diags.Append(mapDiags...)
+		obj, objDiags := types.ObjectValue(staticSiteHeaderRuleAttrTypes, map[string]attr.Value{
+			"path":   types.StringValue(rule.Path),
+			"values": values,
+		})
+		diags.Append(objDiags...)
+		elems = append(elems, obj)
+	}
+	list, listDiags := types.ListValue(types.ObjectType{AttrTypes: staticSiteHeaderRuleAttrTypes}, elems)
+	diags.Append(listDiags...)
+	return list
+}
+
+// flattenStaticSiteBasicAuth builds the basic_auth attribute. The API never
+// returns the password, so it is carried over from prior, which is null after
+// an import.
+func flattenStaticSiteBasicAuth(ctx context.Context, basicAuth *client.StaticSiteBasicAuth, prior types.Object, diags *diag.Diagnostics) types.Object {
+	password := types.StringNull()
+	if !prior.IsNull() && !prior.IsUnknown() {
+		var model StaticSiteBasicAuthModel
+		diags.Append(prior.As(ctx, &model, basetypes.ObjectAsOptions{})...)
+		password = model.Password
+	}
+
+	obj, objDiags := types.ObjectValue(staticSiteBasicAuthAttrTypes, map[string]attr.Value{
+		"username": types.StringValue(basicAuth.Username),
+		"password": password,
+	})
+	diags.Append(objDiags...)
+	return obj
+}
+
+func (r *StaticSiteResource) mapSiteToState(ctx context.Context, site *client.StaticSite, data *StaticSiteResourceModel, diags *diag.Diagnostics) {
 	data.ID = types.StringValue(site.ID)
 	data.Name = types.StringValue(site.Name)
 	data.Slug = types.StringValue(site.Slug)
 	data.URL = types.StringValue(site.URL)
 	data.Plan = types.StringValue(site.Plan)
+	data.RedirectToPrimary = types.BoolValue(site.RedirectToPrimary)
+
+	if len(site.Redirects) == 0 {
+		data.Redirects = types.ListNull(types.ObjectType{AttrTypes: staticSiteRedirectAttrTypes})
+	} else {
+		data.Redirects = flattenStaticSiteRedirects(site.Redirects, diags)
+	}
+	if len(site.Headers) == 0 {
+		data.Headers = types.ListNull(types.ObjectType{AttrTypes: staticSiteHeaderRuleAttrTypes})
+	} else {
+		data.Headers = flattenStaticSiteHeaders(ctx, site.Headers, diags)
+	}
+	if site.BasicAuth == nil {
+		data.BasicAuth = types.ObjectNull(staticSiteBasicAuthAttrTypes)
+	} else {
+		data.BasicAuth = flattenStaticSiteBasicAuth(ctx, site.BasicAuth, data.BasicAuth, diags)
+	}
+
 	data.Status = types.StringValue(site.Status)
 	data.CreatedAt = types.StringValue(site.CreatedAt)
 	data.UpdatedAt = types.StringValue(site.UpdatedAt)
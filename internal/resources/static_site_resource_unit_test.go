@@ -0,0 +1,165 @@
+package resources
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/AdrianSilaghi/terraform-provider-danubedata/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestParsePathGlob(t *testing.T) {
+	tests := []struct {
+		pattern string
+		wantErr string
+	}{
+		{pattern: "/"},
+		{pattern: "/blog/*"},
+		{pattern: "/assets/**"},
+		{pattern: "/*.html"},
+		{pattern: "/docs/v[12]/*"},
+		{pattern: "blog/*", wantErr: "must start with '/'"},
+		{pattern: "/old page", wantErr: "must not contain whitespace"},
+		{pattern: "/search?q=*", wantErr: "query strings and fragments"},
+		{pattern: "/a/***", wantErr: "at most two consecutive"},
+		{pattern: "/assets/**.js", wantErr: "'**' must be a whole path segment"},
+		{pattern: "/docs/v[1-", wantErr: "invalid character class"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.pattern, func(t *testing.T) {
+			err := parsePathGlob(tt.pattern)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("error = %v, want it to contain %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func staticSiteRedirects(t *testing.T, rules ...[2]string) types.List {
+	t.Helper()
+	elems := make([]attr.Value, 0, len(rules))
+	for _, rule := range rules {
+		elems = append(elems, types.ObjectValueMust(staticSiteRedirectAttrTypes, map[string]attr.Value{
+			"from":   types.StringValue(rule[0]),
+			"to":     types.StringValue(rule[1]),
+			"status": types.Int64Value(301),
+		}))
+	}
+	return types.ListValueMust(types.ObjectType{AttrTypes: staticSiteRedirectAttrTypes}, elems)
+}
+
+func TestValidateStaticSiteRedirects(t *testing.T) {
+	tests := []struct {
+		name    string
+		rules   [][2]string
+		wantErr string
+	}{
+		{name: "valid", rules: [][2]string{{"/blog/*", "/articles/:splat"}, {"/old", "https://example.com/new"}}},
+		{name: "wildcard to itself", rules: [][2]string{{"/*", "/*"}}},
+		{name: "duplicate", rules: [][2]string{{"/old", "/new"}, {"/old", "/newer"}}, wantErr: "Duplicate Redirect"},
+		{name: "splat without wildcard", rules: [][2]string{{"/old", "/new/:splat"}}, wantErr: "Invalid Redirect Target"},
+		{name: "loop", rules: [][2]string{{"/same", "/same"}}, wantErr: "Redirect Loop"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			validateStaticSiteRedirects(context.Background(), staticSiteRedirects(t, tt.rules...), &diags)
+			if tt.wantErr == "" {
+				if diags.HasError() {
+					t.Errorf("unexpected errors: %v", diags)
+				}
+				return
+			}
+			if !diags.HasError() || diags.Errors()[0].Summary() != tt.wantErr {
+				t.Errorf("errors = %v, want %q", diags, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestExpandStaticSiteConfig_ClearsUnsetSettings(t *testing.T) {
+	var diags diag.Diagnostics
+	data := StaticSiteResourceModel{
+		Redirects: types.ListNull(types.ObjectType{AttrTypes: staticSiteRedirectAttrTypes}),
+		Headers: types.ListValueMust(types.ObjectType{AttrTypes: staticSiteHeaderRuleAttrTypes}, []attr.Value{
+			types.ObjectValueMust(staticSiteHeaderRuleAttrTypes, map[string]attr.Value{
+				"path": types.StringValue("/assets/**"),
+				"values": types.MapValueMust(types.StringType, map[string]attr.Value{
+					"Cache-Control": types.StringValue("public, max-age=31536000, immutable"),
+				}),
+			}),
+		}),
+		BasicAuth: types.ObjectNull(staticSiteBasicAuthAttrTypes),
+	}
+
+	config := expandStaticSiteConfig(context.Background(), &data, &diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected errors: %v", diags)
+	}
+	if config.Redirects == nil || len(config.Redirects) != 0 {
+		t.Errorf("Redirects = %#v, want an empty non-nil slice", config.Redirects)
+	}
+	if len(config.Headers) != 1 || config.Headers[0].Values["Cache-Control"] != "public, max-age=31536000, immutable" {
+		t.Errorf("Headers = %#v", config.Headers)
+	}
+	if config.BasicAuth != nil {
+		t.Errorf("BasicAuth = %#v, want nil", config.BasicAuth)
+	}
+}
+
+func TestMapSiteToState_KeepsBasicAuthPassword(t *testing.T) {
+	var diags diag.Diagnostics
+	r := &StaticSiteResource{}
+	data := StaticSiteResourceModel{
+		BasicAuth: types.ObjectValueMust(staticSiteBasicAuthAttrTypes, map[string]attr.Value{
+			"username": types.StringValue("preview"),
+			"password": types.StringValue("s3cret-preview"),
+		}),
+	}
+
+	r.mapSiteToState(context.Background(), &client.StaticSite{
+		ID:        "site-123",
+		BasicAuth: &client.StaticSiteBasicAuth{Username: "reviewer"},
+	}, &data, &diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected errors: %v", diags)
+	}
+
+	attrs := data.BasicAuth.Attributes()
+	if got := attrs["username"].(types.String).ValueString(); got != "reviewer" {
+		t.Errorf("username = %q, want reviewer", got)
+	}
+	if got := attrs["password"].(types.String).ValueString(); got != "s3cret-preview" {
+		t.Errorf("password = %q, want it carried over from state", got)
+	}
+	if !data.Redirects.IsNull() || !data.Headers.IsNull() {
+		t.Errorf("Redirects/Headers = %v/%v, want null when the API returns none", data.Redirects, data.Headers)
+	}
+}
+
+func TestMapSiteToState_BasicAuthRemoved(t *testing.T) {
+	var diags diag.Diagnostics
+	r := &StaticSiteResource{}
+	data := StaticSiteResourceModel{
+		BasicAuth: types.ObjectValueMust(staticSiteBasicAuthAttrTypes, map[string]attr.Value{
+			"username": types.StringValue("preview"),
+			"password": types.StringValue("s3cret-preview"),
+		}),
+	}
+
+	r.mapSiteToState(context.Background(), &client.StaticSite{ID: "site-123"}, &data, &diags)
+	if !data.BasicAuth.IsNull() {
+		t.Errorf("BasicAuth = %v, want null after it was removed outside Terraform", data.BasicAuth)
+	}
+}
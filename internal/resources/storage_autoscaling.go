@@ -0,0 +1,180 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/AdrianSilaghi/terraform-provider-danubedata/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// StorageAutoscalingModel is the storage_autoscaling attribute of
+// danubedata_database.
+type StorageAutoscalingModel struct {
+	Enabled          types.Bool  `tfsdk:"enabled"`
+	MaxStorageGB     types.Int64 `tfsdk:"max_storage_gb"`
+	ThresholdPercent types.Int64 `tfsdk:"threshold_percent"`
+	IncrementGB      types.Int64 `tfsdk:"increment_gb"`
+}
+
+// storageAutoscalingAttrTypes describes the object type of the storage_autoscaling attribute.
+var storageAutoscalingAttrTypes = map[string]attr.Type{
+	"enabled":           types.BoolType,
+	"max_storage_gb":    types.Int64Type,
+	"threshold_percent": types.Int64Type,
+	"increment_gb":      types.Int64Type,
+}
+
+// storageAutoscalingAttribute returns the schema of the storage_autoscaling
+// attribute.
+func storageAutoscalingAttribute() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Description: "Grows storage automatically before it fills up. The platform adds increment_gb whenever usage reaches threshold_percent, up to max_storage_gb. " +
+			"Growth beyond storage_size_gb is expected and not reported as drift, also after autoscaling is disabled.",
+		Optional: true,
+		Computed: true,
+		PlanModifiers: []planmodifier.Object{
+			objectplanmodifier.UseStateForUnknown(),
+		},
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				Description: "Whether storage grows automatically.",
+				Required:    true,
+			},
+			"max_storage_gb": schema.Int64Attribute{
+				Description: "Size in GB storage never grows beyond. Required when enabled; must exceed storage_size_gb.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"threshold_percent": schema.Int64Attribute{
+				Description: "Usage, as a percentage of the provisioned size, that triggers growth. Between 50 and 95; defaults to 90.",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(90),
+				Validators: []validator.Int64{
+					int64validator.Between(50, 95),
+				},
+			},
+			"increment_gb": schema.Int64Attribute{
+				Description: "GB added each time storage grows. At least 1; defaults to 10.",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(10),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+// validateStorageAutoscaling checks the storage_autoscaling fields against
+// each other and against storage_size_gb.
+func validateStorageAutoscaling(ctx context.Context, obj types.Object, storageSizeGB types.Int64, diags *diag.Diagnostics) {
+	if obj.IsNull() || obj.IsUnknown() {
+		return
+	}
+
+	var autoscaling StorageAutoscalingModel
+	diags.Append(obj.As(ctx, &autoscaling, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() || !autoscaling.Enabled.ValueBool() || autoscaling.MaxStorageGB.IsUnknown() {
+		return
+	}
+
+	if autoscaling.MaxStorageGB.IsNull() {
+		diags.AddAttributeError(
+			path.Root("storage_autoscaling").AtName("max_storage_gb"),
+			"Missing Storage Autoscaling Limit",
+			"max_storage_gb is required when storage autoscaling is enabled, so that storage cannot grow without bound.",
+		)
+		return
+	}
+
+	if !storageSizeGB.IsNull() && !storageSizeGB.IsUnknown() && autoscaling.MaxStorageGB.ValueInt64() <= storageSizeGB.ValueInt64() {
+		diags.AddAttributeError(
+			path.Root("storage_autoscaling").AtName("max_storage_gb"),
+			"Storage Autoscaling Limit Too Low",
+			fmt.Sprintf("max_storage_gb (%d) must exceed storage_size_gb (%d), or storage can never grow.",
+				autoscaling.MaxStorageGB.ValueInt64(), storageSizeGB.ValueInt64()),
+		)
+	}
+}
+
+// expandStorageAutoscaling converts the storage_autoscaling attribute into
+// its API form. It returns nil when the attribute is null or unknown.
+func expandStorageAutoscaling(ctx context.Context, obj types.Object, diags *diag.Diagnostics) *client.StorageAutoscaling {
+	if obj.IsNull() || obj.IsUnknown() {
+		return nil
+	}
+
+	var autoscaling StorageAutoscalingModel
+	diags.Append(obj.As(ctx, &autoscaling, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return nil
+	}
+
+	return &client.StorageAutoscaling{
+		Enabled:          autoscaling.Enabled.ValueBool(),
+		MaxStorageGB:     int(autoscaling.MaxStorageGB.ValueInt64()),
+		ThresholdPercent: int(autoscaling.ThresholdPercent.ValueInt64()),
+		IncrementGB:      int(autoscaling.IncrementGB.ValueInt64()),
+	}
+}
+
+func flattenStorageAutoscaling(autoscaling *client.StorageAutoscaling) types.Object {
+	if autoscaling == nil {
+		return types.ObjectNull(storageAutoscalingAttrTypes)
+	}
+
+	// A disabled configuration may come back without a limit.
+	maxStorageGB := types.Int64Null()
+	if autoscaling.MaxStorageGB > 0 {
+		maxStorageGB = types.Int64Value(int64(autoscaling.MaxStorageGB))
+	}
+	return types.ObjectValueMust(storageAutoscalingAttrTypes, map[string]attr.Value{
+		"enabled":           types.BoolValue(autoscaling.Enabled),
+		"max_storage_gb":    maxStorageGB,
+		"threshold_percent": types.Int64Value(int64(autoscaling.ThresholdPercent)),
+		"increment_gb":      types.Int64Value(int64(autoscaling.IncrementGB)),
+	})
+}
+
+// storageAutoscalingUsed reports whether a storage_autoscaling value is or
+// was enabled. Enabling requires max_storage_gb, so a limit still set after
+// enabled is switched off marks past use.
+func storageAutoscalingUsed(obj types.Object) bool {
+	if obj.IsNull() || obj.IsUnknown() {
+		return false
+	}
+	attrs := obj.Attributes()
+	enabled, _ := attrs["enabled"].(types.Bool)
+	maxStorageGB, _ := attrs["max_storage_gb"].(types.Int64)
+	return enabled.ValueBool() || maxStorageGB.ValueInt64() > 0
+}
+
+// storageUsedPercent returns used as a percentage of sizeGB, rounded to one
+// decimal place, or null when either is not known.
+func storageUsedPercent(used *float64, sizeGB int) types.Float64 {
+	if used == nil || sizeGB <= 0 {
+		return types.Float64Null()
+	}
+	return types.Float64Value(math.Round(*used/float64(sizeGB)*1000) / 10)
+}
@@ -0,0 +1,92 @@
+package resources
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/AdrianSilaghi/terraform-provider-danubedata/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func testStorageAutoscaling(enabled bool, maxStorageGB types.Int64) types.Object {
+	return types.ObjectValueMust(storageAutoscalingAttrTypes, map[string]attr.Value{
+		"enabled":           types.BoolValue(enabled),
+		"max_storage_gb":    maxStorageGB,
+		"threshold_percent": types.Int64Value(90),
+		"increment_gb":      types.Int64Value(10),
+	})
+}
+
+func TestValidateStorageAutoscaling(t *testing.T) {
+	tests := []struct {
+		name        string
+		autoscaling types.Object
+		storageGB   types.Int64
+		wantErr     string
+	}{
+		{name: "not configured", autoscaling: types.ObjectNull(storageAutoscalingAttrTypes), storageGB: types.Int64Value(20)},
+		{name: "valid", autoscaling: testStorageAutoscaling(true, types.Int64Value(100)), storageGB: types.Int64Value(20)},
+		{name: "disabled without limit", autoscaling: testStorageAutoscaling(false, types.Int64Null()), storageGB: types.Int64Value(20)},
+		{name: "storage size not configured", autoscaling: testStorageAutoscaling(true, types.Int64Value(100)), storageGB: types.Int64Null()},
+		{name: "limit unknown", autoscaling: testStorageAutoscaling(true, types.Int64Unknown()), storageGB: types.Int64Value(20)},
+		{name: "missing limit", autoscaling: testStorageAutoscaling(true, types.Int64Null()), storageGB: types.Int64Value(20), wantErr: "max_storage_gb is required"},
+		{name: "limit not above size", autoscaling: testStorageAutoscaling(true, types.Int64Value(20)), storageGB: types.Int64Value(20), wantErr: "must exceed storage_size_gb"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			validateStorageAutoscaling(context.Background(), tt.autoscaling, tt.storageGB, &diags)
+
+			if tt.wantErr == "" {
+				if diags.HasError() {
+					t.Fatalf("unexpected errors: %v", diags)
+				}
+				return
+			}
+			if !diags.HasError() {
+				t.Fatalf("expected error containing %q", tt.wantErr)
+			}
+			if detail := diags.Errors()[0].Detail(); !strings.Contains(detail, tt.wantErr) {
+				t.Errorf("error = %q, want it to contain %q", detail, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestStorageAutoscalingRoundTrip(t *testing.T) {
+	autoscaling := &client.StorageAutoscaling{Enabled: true, MaxStorageGB: 200, ThresholdPercent: 85, IncrementGB: 20}
+
+	var diags diag.Diagnostics
+	got := expandStorageAutoscaling(context.Background(), flattenStorageAutoscaling(autoscaling), &diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected errors: %v", diags)
+	}
+	if got == nil || *got != *autoscaling {
+		t.Errorf("expandStorageAutoscaling() = %+v, want %+v", got, autoscaling)
+	}
+
+	disabled := flattenStorageAutoscaling(&client.StorageAutoscaling{ThresholdPercent: 90, IncrementGB: 10})
+	if v := disabled.Attributes()["max_storage_gb"]; !v.IsNull() {
+		t.Errorf("max_storage_gb = %v, want null without a limit", v)
+	}
+	if got := flattenStorageAutoscaling(nil); !got.IsNull() {
+		t.Errorf("flattenStorageAutoscaling(nil) = %v, want null", got)
+	}
+}
+
+func TestStorageUsedPercent(t *testing.T) {
+	used := 13.37
+	if got := storageUsedPercent(&used, 20); got.ValueFloat64() != 66.9 {
+		t.Errorf("storageUsedPercent() = %v, want 66.9", got)
+	}
+	if got := storageUsedPercent(nil, 20); !got.IsNull() {
+		t.Errorf("storageUsedPercent(nil) = %v, want null", got)
+	}
+	if got := storageUsedPercent(&used, 0); !got.IsNull() {
+		t.Errorf("storageUsedPercent(0 GB) = %v, want null", got)
+	}
+}
@@ -0,0 +1,327 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+	// Embeds the IANA time zone database, so that timeZone validates the
+	// same way on hosts without one installed, such as Windows.
+	_ "time/tzdata"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var (
+	_ validator.String = ipOrCIDRValidator{}
+	_ validator.String = cronExpressionValidator{}
+	_ validator.String = pathGlobValidator{}
+	_ validator.String = rfc3339Validator{}
+	_ validator.String = timeZoneValidator{}
+)
+
+// ipOrCIDRValidator checks that a string is a bare IPv4/IPv6 address or a CIDR block.
+type ipOrCIDRValidator struct{}
+
+// ipOrCIDR returns a validator accepting a single IP address or CIDR block,
+// e.g. "203.0.113.7", "203.0.113.0/24" or "2001:db8::/32".
+func ipOrCIDR() validator.String {
+	return ipOrCIDRValidator{}
+}
+
+func (v ipOrCIDRValidator) Description(ctx context.Context) string {
+	return "value must be an IP address or CIDR block"
+}
+
+func (v ipOrCIDRValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v ipOrCIDRValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	if net.ParseIP(value) != nil {
+		return
+	}
+	if _, _, err := net.ParseCIDR(value); err == nil {
+		return
+	}
+
+	resp.Diagnostics.AddAttributeError(
+		req.Path,
+		"Invalid IP Address or CIDR Block",
+		fmt.Sprintf("%q is neither an IP address nor a CIDR block (e.g. 203.0.113.0/24).", value),
+	)
+}
+
+// cronExpressionValidator checks that a string is a standard five-field cron
+// expression or one of the @-macros.
+type cronExpressionValidator struct{}
+
+// cronExpression returns a validator accepting expressions such as "0 3 * * *",
+// "*/15 9-17 * * MON-FRI" or "@daily".
+func cronExpression() validator.String {
+	return cronExpressionValidator{}
+}
+
+func (v cronExpressionValidator) Description(ctx context.Context) string {
+	return "value must be a five-field cron expression (minute hour day-of-month month day-of-week) or an @-macro such as @daily"
+}
+
+func (v cronExpressionValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v cronExpressionValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	if err := parseCronExpression(value); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Cron Expression",
+			fmt.Sprintf("%q is not a valid cron expression: %s. Use five fields, minute hour day-of-month month day-of-week, e.g. \"0 3 * * *\" for 03:00 every day.", value, err),
+		)
+	}
+}
+
+var cronMacros = map[string]bool{
+	"@yearly": true, "@annually": true, "@monthly": true, "@weekly": true,
+	"@daily": true, "@midnight": true, "@hourly": true,
+}
+
+// cronField describes the allowed values of one cron field. Names are matched
+// case-insensitively and map to min+index.
+type cronField struct {
+	name     string
+	min, max int
+	names    []string
+}
+
+var cronFields = []cronField{
+	{name: "minute", min: 0, max: 59},
+	{name: "hour", min: 0, max: 23},
+	{name: "day-of-month", min: 1, max: 31},
+	{name: "month", min: 1, max: 12, names: []string{"JAN", "FEB", "MAR", "APR", "MAY", "JUN", "JUL", "AUG", "SEP", "OCT", "NOV", "DEC"}},
+	{name: "day-of-week", min: 0, max: 7, names: []string{"SUN", "MON", "TUE", "WED", "THU", "FRI", "SAT"}}, // 0 and 7 are both Sunday
+}
+
+// parseCronExpression reports why expr is not a valid cron expression, or nil.
+func parseCronExpression(expr string) error {
+	if strings.HasPrefix(expr, "@") {
+		if cronMacros[strings.ToLower(expr)] {
+			return nil
+		}
+		return fmt.Errorf("unknown macro %s (supported: @yearly, @annually, @monthly, @weekly, @daily, @midnight, @hourly)", expr)
+	}
+
+	fields := strings.Fields(expr)
+	if len(fields) != len(cronFields) {
+		return fmt.Errorf("expected %d fields, got %d", len(cronFields), len(fields))
+	}
+	for i, field := range fields {
+		for _, item := range strings.Split(field, ",") {
+			if err := cronFields[i].parseItem(item); err != nil {
+				return fmt.Errorf("%s field %q: %w", cronFields[i].name, field, err)
+			}
+		}
+	}
+	return nil
+}
+
+// parseItem validates one comma-separated item: *, a value, or a range, each
+// optionally followed by /step.
+func (f cronField) parseItem(item string) error {
+	rangePart, step, hasStep := strings.Cut(item, "/")
+	if hasStep {
+		n, err := strconv.Atoi(step)
+		if err != nil || n < 1 {
+			return fmt.Errorf("step %q must be a positive number", step)
+		}
+	}
+
+	if rangePart == "*" {
+		return nil
+	}
+
+	lo, hi, isRange := strings.Cut(rangePart, "-")
+	start, err := f.value(lo)
+	if err != nil {
+		return err
+	}
+	if !isRange {
+		if hasStep {
+			return fmt.Errorf("step requires * or a range, e.g. */%s", step)
+		}
+		return nil
+	}
+	end, err := f.value(hi)
+	if err != nil {
+		return err
+	}
+	if start > end {
+		return fmt.Errorf("range %s is backwards", rangePart)
+	}
+	return nil
+}
+
+func (f cronField) value(s string) (int, error) {
+	for i, name := range f.names {
+		if strings.EqualFold(s, name) {
+			return f.min + i, nil
+		}
+	}
+
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("%q is not a number", s)
+	}
+	if n < f.min || n > f.max {
+		return 0, fmt.Errorf("%d is out of range %d-%d", n, f.min, f.max)
+	}
+	return n, nil
+}
+
+// pathGlobValidator checks that a string is a URL path pattern.
+type pathGlobValidator struct{}
+
+// pathGlob returns a validator accepting URL path patterns such as "/blog/*",
+// "/assets/**" or "/*.html". A single * matches within one path segment, **
+// matches across segments.
+func pathGlob() validator.String {
+	return pathGlobValidator{}
+}
+
+func (v pathGlobValidator) Description(ctx context.Context) string {
+	return "value must be a URL path pattern starting with '/', where * matches within a path segment and ** across segments"
+}
+
+func (v pathGlobValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v pathGlobValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	if err := parsePathGlob(value); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Path Pattern",
+			fmt.Sprintf("%q is not a valid path pattern: %s. Patterns start with '/', e.g. \"/blog/*\" or \"/assets/**\".", value, err),
+		)
+	}
+}
+
+// parsePathGlob reports why pattern is not a valid path pattern, or nil.
+func parsePathGlob(pattern string) error {
+	if !strings.HasPrefix(pattern, "/") {
+		return fmt.Errorf("must start with '/'")
+	}
+	if strings.ContainsAny(pattern, " \t\r\n") {
+		return fmt.Errorf("must not contain whitespace")
+	}
+	if i := strings.IndexAny(pattern, "?#"); i >= 0 {
+		return fmt.Errorf("query strings and fragments are not matched; remove everything from %q", pattern[i:])
+	}
+	if strings.Contains(pattern, "***") {
+		return fmt.Errorf("at most two consecutive '*' are allowed")
+	}
+	for _, segment := range strings.Split(pattern, "/") {
+		if strings.Contains(segment, "**") && segment != "**" {
+			return fmt.Errorf("'**' must be a whole path segment, as in /assets/**")
+		}
+	}
+	// path.Match reports malformed character classes such as "[a-".
+	if _, err := path.Match(pattern, "/"); err != nil {
+		return fmt.Errorf("unbalanced '[' or invalid character class")
+	}
+	return nil
+}
+
+// rfc3339Validator checks that a string is an RFC 3339 timestamp.
+type rfc3339Validator struct{}
+
+// rfc3339Timestamp returns a validator accepting RFC 3339 timestamps such as
+// "2026-01-02T15:04:05Z" or "2026-01-02T17:04:05+02:00".
+func rfc3339Timestamp() validator.String {
+	return rfc3339Validator{}
+}
+
+func (v rfc3339Validator) Description(ctx context.Context) string {
+	return "value must be an RFC 3339 timestamp"
+}
+
+func (v rfc3339Validator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v rfc3339Validator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	if _, err := time.Parse(time.RFC3339, value); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Timestamp",
+			fmt.Sprintf("%q is not an RFC 3339 timestamp such as 2026-01-02T15:04:05Z.", value),
+		)
+	}
+}
+
+// timeZoneValidator checks that a string is an IANA time zone name.
+type timeZoneValidator struct{}
+
+// timeZone returns a validator accepting IANA time zone names such as "UTC"
+// or "Europe/Bucharest".
+func timeZone() validator.String {
+	return timeZoneValidator{}
+}
+
+func (v timeZoneValidator) Description(ctx context.Context) string {
+	return "value must be an IANA time zone name such as Europe/Bucharest"
+}
+
+func (v timeZoneValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v timeZoneValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	if err := parseTimeZone(value); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Time Zone",
+			fmt.Sprintf("%q is not a valid time zone: %s. Use an IANA name such as \"UTC\" or \"Europe/Bucharest\".", value, err),
+		)
+	}
+}
+
+// parseTimeZone reports why name is not an IANA time zone name, or nil.
+func parseTimeZone(name string) error {
+	// time.LoadLocation maps "" to UTC and "Local" to the host's zone, neither
+	// of which means anything to the API.
+	if name == "" || name == "Local" {
+		return fmt.Errorf("not an IANA time zone name")
+	}
+	if _, err := time.LoadLocation(name); err != nil {
+		return fmt.Errorf("unknown time zone")
+	}
+	return nil
+}